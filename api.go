@@ -0,0 +1,154 @@
+package polygonio
+
+import (
+	"context"
+	"time"
+)
+
+// The interfaces below cover the endpoint methods of Client, so that code
+// depending on them can be tested against polygontest.FakeClient or any
+// other implementation. Iterators, pagers and client configuration are not
+// part of them.
+
+type ReferenceAPI interface {
+	ReferenceTickers(opts *TickerOptions) (Tickers, error)
+	ReferenceTickersContext(ctx context.Context, opts *TickerOptions) (Tickers, error)
+	ReferenceTickerTypes() (map[string]string, map[string]string, error)
+	ReferenceTickerTypesContext(ctx context.Context) (map[string]string, map[string]string, error)
+	ReferenceTickerDetail(ticker string) (TickerDetails, error)
+	ReferenceTickerDetailContext(ctx context.Context, ticker string) (TickerDetails, error)
+	ReferenceTickerNews(ticker string, opts *NewsOptions) ([]TickerNews, error)
+	ReferenceTickerNewsContext(ctx context.Context, ticker string, opts *NewsOptions) ([]TickerNews, error)
+	ReferenceMarkets() (MarketDescriptions, error)
+	ReferenceMarketsContext(ctx context.Context) (MarketDescriptions, error)
+	ReferenceLocales() (LocaleNames, error)
+	ReferenceLocalesContext(ctx context.Context) (LocaleNames, error)
+	ReferenceStockSplits(ticker string) (Splits, error)
+	ReferenceStockSplitsContext(ctx context.Context, ticker string) (Splits, error)
+	ReferenceDividends(ticker string) (Dividends, error)
+	ReferenceDividendsContext(ctx context.Context, ticker string) (Dividends, error)
+	ReferenceFinancials(ticker string, opts *FinancialOptions) (Financials, error)
+	ReferenceFinancialsContext(ctx context.Context, ticker string, opts *FinancialOptions) (Financials, error)
+	ReferenceMarketStatus() (MarketStatus, error)
+	ReferenceMarketStatusContext(ctx context.Context) (MarketStatus, error)
+	ReferenceMarketHolidays() (MarketHolidays, error)
+	ReferenceMarketHolidaysContext(ctx context.Context) (MarketHolidays, error)
+}
+
+type StocksAPI interface {
+	StockExchanges() (Exchanges, error)
+	StockExchangesContext(ctx context.Context) (Exchanges, error)
+	StockPreviousClose(ticker string, opts *RequestOptions) (*Bars, error)
+	StockPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error)
+	StockAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	StockAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	StockAggregatesMillis(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	StockAggregatesMillisContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	StockAggregatesEach(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions, fn func(Bar) error) error
+	StockAggregatesBatch(ctx context.Context, tickers []string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions, concurrency int) AggregatesBatch
+	StockGroupedDaily(locale Locale, market Market, date time.Time, opts *RequestOptions) (*Bars, error)
+	StockGroupedDailyContext(ctx context.Context, locale Locale, market Market, date time.Time, opts *RequestOptions) (*Bars, error)
+	StockTrades(ticker string, date time.Time, opts *RequestOptions) (*Trades, error)
+	StockTradesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) (*Trades, error)
+	StockTradesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Trade) error) error
+	StockDailyTrades(ticker string, date time.Time, opts *RequestOptions) ([]*Trades, error)
+	StockDailyTradesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) ([]*Trades, error)
+	StockDailyTradesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Trade) error) error
+	StockQuotes(ticker string, date time.Time, opts *RequestOptions) (*Quotes, error)
+	StockQuotesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) (*Quotes, error)
+	StockQuotesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Quote) error) error
+	StockDailyQuotes(ticker string, date time.Time, opts *RequestOptions) ([]*Quotes, error)
+	StockDailyQuotesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) ([]*Quotes, error)
+	StockDailyQuotesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Quote) error) error
+	StockLastTrade(ticker string) (LastTrade, error)
+	StockLastTradeContext(ctx context.Context, ticker string) (LastTrade, error)
+	StockLastQuote(ticker string) (LastQuote, error)
+	StockLastQuoteContext(ctx context.Context, ticker string) (LastQuote, error)
+	StockDaily(ticker string, date time.Time) (*Daily, error)
+	StockDailyContext(ctx context.Context, ticker string, date time.Time) (*Daily, error)
+	StockConditionMappings(tick Tick) (map[string]string, error)
+	StockConditionMappingsContext(ctx context.Context, tick Tick) (map[string]string, error)
+	StockSnapshotAll() (*Snapshots, error)
+	StockSnapshotAllContext(ctx context.Context) (*Snapshots, error)
+	StockSnapshotAllEach(ctx context.Context, fn func(Snapshot) error) error
+	StockSnapshotSingle(ticker string) (*Snapshot, error)
+	StockSnapshotSingleContext(ctx context.Context, ticker string) (*Snapshot, error)
+	StockSnapshotTopGainersLosers(direction Direction) (*Snapshots, error)
+	StockSnapshotTopGainersLosersContext(ctx context.Context, direction Direction) (*Snapshots, error)
+	StockTradesV3(ticker string, opts *V3TickOptions) (V3Trades, string, error)
+	StockTradesV3Context(ctx context.Context, ticker string, opts *V3TickOptions) (V3Trades, string, error)
+	StockQuotesV3(ticker string, opts *V3TickOptions) (V3Quotes, string, error)
+	StockQuotesV3Context(ctx context.Context, ticker string, opts *V3TickOptions) (V3Quotes, string, error)
+}
+
+type CryptoAPI interface {
+	CryptoExchanges() (Exchanges, error)
+	CryptoExchangesContext(ctx context.Context) (Exchanges, error)
+	CryptoPreviousClose(ticker string, opts *RequestOptions) (*Bars, error)
+	CryptoPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error)
+	CryptoAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	CryptoAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	CryptoGroupedDaily(locale Locale, date time.Time, opts *RequestOptions) (*Bars, error)
+	CryptoGroupedDailyContext(ctx context.Context, locale Locale, date time.Time, opts *RequestOptions) (*Bars, error)
+	CryptoDaily(from, to, date string) (CryptoDaily, error)
+	CryptoDailyContext(ctx context.Context, from, to, date string) (CryptoDaily, error)
+	CryptoLastTradeForCryptoPair(pair CurrencyPair) (CryptoLastTrade, error)
+	CryptoLastTradeForCryptoPairContext(ctx context.Context, pair CurrencyPair) (CryptoLastTrade, error)
+	CryptoHistoricTrades(pair CurrencyPair, date time.Time, opts *CryptoTradesOptions) (*CryptoTrades, error)
+	CryptoHistoricTradesContext(ctx context.Context, pair CurrencyPair, date time.Time, opts *CryptoTradesOptions) (*CryptoTrades, error)
+	CryptoSnapshotAll() (*CryptoSnapshots, error)
+	CryptoSnapshotAllContext(ctx context.Context) (*CryptoSnapshots, error)
+	CryptoSnapshotFullBook(ticker string) (CryptoBook, error)
+	CryptoSnapshotFullBookContext(ctx context.Context, ticker string) (CryptoBook, error)
+}
+
+type ForexAPI interface {
+	ForexPreviousClose(ticker string, opts *RequestOptions) (*Bars, error)
+	ForexPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error)
+	ForexAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	ForexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	ForexGroupedDaily(locale Locale, date time.Time, opts *RequestOptions) (*Bars, error)
+	ForexGroupedDailyContext(ctx context.Context, locale Locale, date time.Time, opts *RequestOptions) (*Bars, error)
+	ForexHistoricTicks(pair CurrencyPair, date time.Time, opts *ForexTicksOptions) (*ForexTicks, error)
+	ForexHistoricTicksContext(ctx context.Context, pair CurrencyPair, date time.Time, opts *ForexTicksOptions) (*ForexTicks, error)
+	ForexRealTimeConversion(pair CurrencyPair, opts *ConversionOptions) (ForexConversion, error)
+	ForexRealTimeConversionContext(ctx context.Context, pair CurrencyPair, opts *ConversionOptions) (ForexConversion, error)
+	ForexLastQuotesForCurrencyPair(pair CurrencyPair) (ForexQuote, error)
+	ForexLastQuotesForCurrencyPairContext(ctx context.Context, pair CurrencyPair) (ForexQuote, error)
+	ForexSnapshotAll() (*ForexSnapshots, error)
+	ForexSnapshotAllContext(ctx context.Context) (*ForexSnapshots, error)
+	ForexSnapshotTopGainersLosers(direction Direction) (*ForexSnapshots, error)
+	ForexSnapshotTopGainersLosersContext(ctx context.Context, direction Direction) (*ForexSnapshots, error)
+}
+
+type OptionsAPI interface {
+	OptionsContract(symbol OptionSymbol) (OptionContract, error)
+	OptionsContractContext(ctx context.Context, symbol OptionSymbol) (OptionContract, error)
+	OptionsPreviousClose(symbol OptionSymbol, opts *RequestOptions) (*Bars, error)
+	OptionsPreviousCloseContext(ctx context.Context, symbol OptionSymbol, opts *RequestOptions) (*Bars, error)
+	OptionsAggregates(symbol OptionSymbol, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	OptionsAggregatesContext(ctx context.Context, symbol OptionSymbol, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error)
+	OptionsChainSnapshot(underlying string, opts *OptionsChainOptions) (OptionSnapshots, error)
+	OptionsChainSnapshotContext(ctx context.Context, underlying string, opts *OptionsChainOptions) (OptionSnapshots, error)
+}
+
+type IndicesAPI interface {
+	IndexPreviousClose(ticker string, opts *RequestOptions) (*IndexBars, error)
+	IndexPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*IndexBars, error)
+	IndexAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*IndexBars, error)
+	IndexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*IndexBars, error)
+	IndexSnapshot(tickers ...string) (IndexSnapshots, error)
+	IndexSnapshotContext(ctx context.Context, tickers ...string) (IndexSnapshots, error)
+}
+
+// API is the full endpoint surface of Client.
+type API interface {
+	ReferenceAPI
+	StocksAPI
+	CryptoAPI
+	ForexAPI
+	OptionsAPI
+	IndicesAPI
+}
+
+var _ API = (*Client)(nil)
@@ -2,25 +2,38 @@ package polygonio
 
 import (
 	"fmt"
+	"os"
 	"testing"
 	"time"
 )
 
+func date(s string) time.Time {
+	d, err := time.Parse(DateLayoutISO, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
 func TestAPICalls(t *testing.T) {
+	apiKey := os.Getenv("POLYGON_API_KEY")
+	if apiKey == "" {
+		t.Skip("POLYGON_API_KEY not set, skipping live API calls")
+	}
 
 	//stream test
 	{
-		stream, err := GetStream("API_KEY", "wss://socket.polygon.io/stocks")
+		stream, err := GetStream(apiKey, "wss://socket.polygon.io/stocks")
 		if err != nil {
-			fmt.Println("error on getting the stream: ", err)
+			t.Fatal("error on getting the stream: ", err)
 		}
 		go func() {
-			for msg := range stream.messageC {
+			for msg := range stream.MessageC {
 				fmt.Println("message: ", string(msg))
 			}
 		}()
 		go func() {
-			for msg := range stream.errorC {
+			for msg := range stream.ErrorC {
 				fmt.Println("message: ", msg.Error())
 			}
 		}()
@@ -56,7 +69,7 @@ func TestAPICalls(t *testing.T) {
 
 	//rest test
 	{
-		client := NewClient("API_KEY")
+		client := NewClient(apiKey)
 		//Reference Endpoints
 		tks, err := client.ReferenceTickers(&TickerOptions{Sort: ZATicker, Market: Stocks})
 		fmt.Println(fmt.Sprintf("%+v", tks))
@@ -112,22 +125,22 @@ func TestAPICalls(t *testing.T) {
 		fmt.Println(fmt.Sprintf("%+v", bars))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		aggs, err := client.StockAggregates("AAPL", 1, Minute, "2021-01-04", "2021-01-05", &RequestOptions{Unadjusted: UnadjustedFalse, Sort: Asc})
+		aggs, err := client.StockAggregates("AAPL", 1, Minute, date("2021-01-04"), date("2021-01-05"), &RequestOptions{Unadjusted: UnadjustedFalse, Sort: Asc})
 		fmt.Println(fmt.Sprintf("%+v", aggs))
 		fmt.Println(fmt.Sprintf("%+v", err))
 		for _, agg := range *aggs {
 			fmt.Println(fmt.Sprintf("%+v", agg))
 		}
 
-		trades, err := client.StockDailyQuotes("AMD", "2020-10-05", nil)
+		trades, err := client.StockDailyQuotes("AMD", date("2020-10-05"), nil)
 		fmt.Println(fmt.Sprintf("%+v", len(trades)))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		grps, err := client.StockGroupedDaily(US, Stocks, "2020-10-05", nil)
+		grps, err := client.StockGroupedDaily(US, Stocks, date("2020-10-05"), nil)
 		fmt.Println(fmt.Sprintf("%+v", grps))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		dls, err := client.StockDaily("AAPL", "2020-10-05")
+		dls, err := client.StockDaily("AAPL", date("2020-10-05"))
 		fmt.Println(fmt.Sprintf("%+v", dls))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
@@ -137,7 +150,7 @@ func TestAPICalls(t *testing.T) {
 
 		opts := RequestOptions{Limit: 100}
 
-		tds, err := client.StockQuotes("AAPL", "2020-10-14", &opts)
+		tds, err := client.StockQuotes("AAPL", date("2020-10-14"), &opts)
 		fmt.Println(fmt.Sprintf("%+v", len(*tds)))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
@@ -164,11 +177,11 @@ func TestAPICalls(t *testing.T) {
 		fmt.Println(fmt.Sprintf("%+v", fpc))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		faggs, err := client.ForexAggregates("C:EURUSD", 1, Minute, "2020-10-05", "2020-10-06", &RequestOptions{Sort: Asc})
+		faggs, err := client.ForexAggregates("C:EURUSD", 1, Minute, date("2020-10-05"), date("2020-10-06"), &RequestOptions{Sort: Asc})
 		fmt.Println(fmt.Sprintf("%+v", faggs))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		fd, err := client.ForexGroupedDaily(US, "2020-10-05", nil)
+		fd, err := client.ForexGroupedDaily(US, date("2020-10-05"), nil)
 		fmt.Println(fmt.Sprintf("%+v", fd))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
@@ -181,11 +194,11 @@ func TestAPICalls(t *testing.T) {
 		fmt.Println(fmt.Sprintf("%+v", cpc))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		caggs, err := client.CryptoAggregates("X:ETHUSDT", 1, Minute, "2020-10-05", "2020-10-06", &RequestOptions{Sort: Asc})
+		caggs, err := client.CryptoAggregates("X:ETHUSDT", 1, Minute, date("2020-10-05"), date("2020-10-06"), &RequestOptions{Sort: Asc})
 		fmt.Println(fmt.Sprintf("%+v", caggs))
 		fmt.Println(fmt.Sprintf("%+v", err))
 
-		cd, err := client.CryptoGroupedDaily(US, "2020-10-05", nil)
+		cd, err := client.CryptoGroupedDaily(US, date("2020-10-05"), nil)
 		fmt.Println(fmt.Sprintf("%+v", cd))
 		fmt.Println(fmt.Sprintf("%+v", err))
 	}
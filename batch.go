@@ -0,0 +1,81 @@
+package polygonio
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultBatchConcurrency is the number of requests a batch method runs in
+// parallel when given a concurrency of zero.
+const DefaultBatchConcurrency = 8
+
+// AggregatesBatch holds the outcome of a batch call per ticker. Every ticker
+// of the batch is either in Bars or in Errors.
+type AggregatesBatch struct {
+	Bars   map[string]*Bars
+	Errors map[string]error
+}
+
+// Err returns one of the per ticker errors, nil if every ticker succeeded.
+func (b AggregatesBatch) Err() error {
+	for _, err := range b.Errors {
+		return err
+	}
+	return nil
+}
+
+// StockAggregatesBatch fetches the aggregates of several tickers with up to
+// concurrency requests in flight. Requests go through the rate limit and
+// retry policy of the Client like any other call. A failing ticker does not
+// stop the batch, its error is reported in Errors; once ctx is done the
+// remaining tickers fail with the context error.
+func (c *Client) StockAggregatesBatch(ctx context.Context, tickers []string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions, concurrency int) AggregatesBatch {
+	return batch(ctx, tickers, concurrency, func(ctx context.Context, ticker string) (*Bars, error) {
+		return c.StockAggregatesContext(ctx, ticker, multiplier, timespan, from, to, opts)
+	})
+}
+
+func batch(ctx context.Context, tickers []string, concurrency int, fetch func(context.Context, string) (*Bars, error)) AggregatesBatch {
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	out := AggregatesBatch{Bars: make(map[string]*Bars), Errors: make(map[string]error)}
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		work = make(chan string)
+	)
+	for i := 0; i < concurrency && i < len(tickers); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ticker := range work {
+				var (
+					bars *Bars
+					err  = ctx.Err()
+				)
+				if err == nil {
+					bars, err = fetch(ctx, ticker)
+				}
+				mu.Lock()
+				if err != nil {
+					out.Errors[ticker] = err
+				} else {
+					out.Bars[ticker] = bars
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	seen := make(map[string]bool, len(tickers))
+	for _, ticker := range tickers {
+		if !seen[ticker] {
+			seen[ticker] = true
+			work <- ticker
+		}
+	}
+	close(work)
+	wg.Wait()
+	return out
+}
@@ -0,0 +1,63 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStockAggregatesBatch(t *testing.T) {
+	var inFlight, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		ticker := strings.Split(r.URL.Path, "/")[4]
+		if ticker == "NOPE" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status":"NOT_FOUND","message":"ticker not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"ticker":%q,"results":[{"c":1,"t":1}]}`, ticker)
+	}))
+	defer srv.Close()
+
+	tickers := []string{"NOPE"}
+	for i := 0; i < 20; i++ {
+		tickers = append(tickers, fmt.Sprintf("T%d", i))
+	}
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+	from := time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+	res := client.StockAggregatesBatch(context.Background(), tickers, 1, Day, from, from, nil, 4)
+
+	if len(res.Bars) != 20 || len(res.Errors) != 1 {
+		t.Fatalf("expected 20 results and 1 error, got %d and %d", len(res.Bars), len(res.Errors))
+	}
+	if !IsNotFound(res.Errors["NOPE"]) {
+		t.Fatalf("expected not found for NOPE, got %v", res.Errors["NOPE"])
+	}
+	if bars := res.Bars["T7"]; bars == nil || len(*bars) != 1 {
+		t.Fatalf("unexpected bars for T7: %v", bars)
+	}
+	if peak > 4 {
+		t.Fatalf("expected at most 4 requests in flight, got %d", peak)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res = client.StockAggregatesBatch(ctx, tickers[:3], 1, Day, from, from, nil, 0)
+	if len(res.Errors) != 3 || res.Err() != context.Canceled {
+		t.Fatalf("expected every ticker to fail with context.Canceled, got %v", res.Errors)
+	}
+}
@@ -0,0 +1,300 @@
+package polygonio
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies. Keys are request URLs without the API
+// key. A ttl of zero or less means the entry never expires.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// StaleCache is implemented by caches that can return expired entries. In
+// offline mode they are preferred over a miss.
+type StaleCache interface {
+	GetStale(key string) ([]byte, bool)
+}
+
+// DefaultCacheTTLs are used for endpoint classes without a WithCacheTTL
+// override. Classes missing here are not cached, apart from settled
+// historical data, see WithCache.
+var DefaultCacheTTLs = map[EndpointClass]time.Duration{
+	ClassReference: 24 * time.Hour,
+}
+
+// settledTTL is used for settled but split adjusted bars, which Polygon
+// rewrites after a split.
+const settledTTL = 24 * time.Hour
+
+// historicalRoutes are endpoints whose last path segment is the (end) date of
+// the data they return.
+var historicalRoutes = map[string]bool{
+	"Aggregates":           true,
+	"GroupedDaily":         true,
+	"DailyOpenClose":       true,
+	"CryptoDailyOpenClose": true,
+}
+
+// WithCache stores successful responses in cache, using DefaultCacheTTLs or
+// the TTLs set with WithCacheTTL. Unadjusted aggregates and daily bars of a
+// settled day, at least one full day in the past, never change and are
+// cached without expiry.
+func WithCache(cache Cache) func(*Client) {
+	return func(client *Client) {
+		client.cache = cache
+	}
+}
+
+// WithCacheTTL overrides the cache TTL of an endpoint class, zero disables
+// caching for the class.
+func WithCacheTTL(class EndpointClass, ttl time.Duration) func(*Client) {
+	return func(client *Client) {
+		if client.cacheTTLs == nil {
+			client.cacheTTLs = make(map[EndpointClass]time.Duration)
+		}
+		client.cacheTTLs[class] = ttl
+	}
+}
+
+// cacheKey removes the API key from a request URL.
+func cacheKey(address string) string {
+	u, err := url.Parse(address)
+	if err != nil {
+		return address
+	}
+	q := u.Query()
+	q.Del("apiKey")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// cacheTTL reports whether the response of address may be cached and for
+// how long, zero meaning forever.
+func (c *Client) cacheTTL(address string, now time.Time) (time.Duration, bool) {
+	r := lookupRoute(address)
+	if historicalRoutes[r.name] {
+		if u, err := url.Parse(address); err == nil && settled(u.Path, now) {
+			if u.Query().Get("unadjusted") == string(UnadjustedTrue) {
+				return 0, true
+			}
+			return settledTTL, true
+		}
+	}
+	ttl, ok := c.cacheTTLs[r.class]
+	if !ok {
+		ttl = DefaultCacheTTLs[r.class]
+	}
+	return ttl, ttl > 0
+}
+
+// settled reports whether the date in the last segment of path, either
+// formatted with DateLayoutISO or in Unix milliseconds, ended at least a full
+// day before now.
+func settled(path string, now time.Time) bool {
+	last := path[strings.LastIndex(path, "/")+1:]
+	var end time.Time
+	if d, err := time.Parse(DateLayoutISO, last); err == nil {
+		end = d.AddDate(0, 0, 1)
+	} else if ms, err := strconv.ParseInt(last, 10, 64); err == nil {
+		end = time.Unix(0, ms*int64(time.Millisecond))
+	} else {
+		return false
+	}
+	return end.Add(24 * time.Hour).Before(now)
+}
+
+// WithOfflineMode serves every request from the cache set with WithCache and
+// never touches the network. Requests that are not cached fail with
+// ErrNotCached. Expired entries are still served if the cache is a
+// StaleCache, so that earlier results can be reproduced.
+//
+// Only responses stored while online can be served, that is endpoint classes
+// with a TTL and settled historical data. Under DefaultCacheTTLs ticks, last
+// trades and quotes and snapshots are never stored, set a TTL for them with
+// WithCacheTTL while recording to use them offline.
+func WithOfflineMode() func(*Client) {
+	return func(client *Client) {
+		client.offline = true
+	}
+}
+
+func (c *Client) cacheGet(address string) ([]byte, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+	if stale, ok := c.cache.(StaleCache); ok && c.offline {
+		return stale.GetStale(cacheKey(address))
+	}
+	return c.cache.Get(cacheKey(address))
+}
+
+// offlineGet returns the cached response of address for the offline mode.
+func (c *Client) offlineGet(address string) ([]byte, error) {
+	if data, ok := c.cacheGet(address); ok {
+		return data, nil
+	}
+	u, err := url.Parse(c.redact(address))
+	if err != nil {
+		return nil, Error{Err: ErrNotCached}
+	}
+	return nil, Error{Err: ErrNotCached, Endpoint: u.RequestURI()}
+}
+
+func (c *Client) cacheSet(address string, data []byte) {
+	if c.cache == nil {
+		return
+	}
+	if ttl, ok := c.cacheTTL(address, time.Now()); ok {
+		c.cache.Set(cacheKey(address), data, ttl)
+	}
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entry once it holds maxEntries.
+type MemoryCache struct {
+	sync.Mutex
+	maxEntries int
+	ll         *list.List
+	entries    map[string]*list.Element
+}
+
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.Lock()
+	defer m.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*memoryEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		m.ll.Remove(el)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+func (m *MemoryCache) GetStale(key string) ([]byte, bool) {
+	m.Lock()
+	defer m.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(el)
+	return el.Value.(*memoryEntry).value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	entry := &memoryEntry{key: key, value: value}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	if el, ok := m.entries[key]; ok {
+		el.Value = entry
+		m.ll.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.ll.PushFront(entry)
+	for m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// DiskCache is a Cache storing one file per entry in a directory, so that it
+// survives process restarts.
+type DiskCache struct {
+	dir string
+}
+
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+// Entries are stored as the expiry in Unix nanoseconds (zero for none)
+// followed by the value.
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(d.path(key))
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	if expires := int64(binary.BigEndian.Uint64(data)); expires != 0 && time.Now().UnixNano() > expires {
+		os.Remove(d.path(key))
+		return nil, false
+	}
+	return data[8:], true
+}
+
+func (d *DiskCache) GetStale(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(d.path(key))
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	return data[8:], true
+}
+
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	var header [8]byte
+	if ttl > 0 {
+		binary.BigEndian.PutUint64(header[:], uint64(time.Now().Add(ttl).UnixNano()))
+	}
+	f, err := ioutil.TempFile(d.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(header[:])
+	if err == nil {
+		_, err = f.Write(value)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+	// rename is atomic, readers never see a partially written entry
+	if err := os.Rename(f.Name(), d.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,173 @@
+package polygonio
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheTTL(t *testing.T) {
+	now := date("2021-03-10").Add(15 * time.Hour)
+	client := NewClient("KEY", WithCacheTTL(ClassAggregates, time.Minute))
+	cases := []struct {
+		address string
+		ttl     time.Duration
+		ok      bool
+	}{
+		{"https://api.polygon.io/v1/meta/symbols/AAPL/company?apiKey=KEY", 24 * time.Hour, true},
+		{"https://api.polygon.io/v2/aggs/ticker/AAPL/range/1/day/2021-01-01/2021-03-01?unadjusted=true", 0, true},
+		{"https://api.polygon.io/v2/aggs/ticker/AAPL/range/1/day/2021-01-01/2021-03-01", settledTTL, true},
+		{"https://api.polygon.io/v2/aggs/ticker/AAPL/range/1/day/2021-01-01/2021-03-09?unadjusted=true", time.Minute, true},
+		{"https://api.polygon.io/v2/aggs/ticker/AAPL/range/1/minute/1609459200000/1609462800000?unadjusted=true", 0, true},
+		{"https://api.polygon.io/v2/ticks/stocks/trades/AAPL/2021-01-04", 0, false},
+		{"https://api.polygon.io/v2/snapshot/locale/us/markets/stocks/tickers", 0, false},
+	}
+	for _, tc := range cases {
+		ttl, ok := client.cacheTTL(tc.address, now)
+		if ttl != tc.ttl || ok != tc.ok {
+			t.Errorf("%s: expected %v %v, got %v %v", tc.address, tc.ttl, tc.ok, ttl, ok)
+		}
+	}
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), 0)
+	cache.Set("b", []byte("2"), 0)
+	cache.Get("a")
+	cache.Set("c", []byte("3"), 0)
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	if v, ok := cache.Get("a"); !ok || string(v) != "1" {
+		t.Fatal("expected a to be kept")
+	}
+	cache.Set("d", []byte("4"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := cache.Get("d"); ok {
+		t.Fatal("expected d to be expired")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "polygon-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Set("a", []byte("1"), 0)
+	cache.Set("b", []byte("2"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if v, ok := cache.Get("a"); !ok || string(v) != "1" {
+		t.Fatal("expected a to be cached")
+	}
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("expected b to be expired")
+	}
+}
+
+func TestClientServesFromCache(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"symbol":"AAPL"}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithCache(NewMemoryCache(10)))
+	for i := 0; i < 3; i++ {
+		details, err := client.ReferenceTickerDetail("AAPL")
+		if err != nil || details.Symbol != "AAPL" {
+			t.Fatalf("unexpected result %+v, %v", details, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+}
+
+func TestMarketStatusIsNotCached(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"market":"open"}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithCache(NewMemoryCache(10)))
+	for i := 0; i < 2; i++ {
+		if _, err := client.ReferenceMarketStatus(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected both requests to reach the server, got %d", calls)
+	}
+}
+
+func TestOfflineMode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"symbol":"AAPL"}`)
+	}))
+	cache := NewMemoryCache(10)
+	online := NewClient("KEY", WithBaseURL(srv.URL), WithCache(cache), WithCacheTTL(ClassReference, time.Nanosecond))
+	if _, err := online.ReferenceTickerDetail("AAPL"); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	time.Sleep(time.Millisecond)
+
+	offline := NewClient("OTHER", WithBaseURL(srv.URL), WithCache(cache), WithOfflineMode())
+	details, err := offline.ReferenceTickerDetail("AAPL")
+	if err != nil || details.Symbol != "AAPL" {
+		t.Fatalf("expected expired entry to be served offline, got %+v, %v", details, err)
+	}
+	_, err = offline.ReferenceTickerDetail("MSFT")
+	if !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "not cached") || strings.Contains(err.Error(), "OTHER") {
+		t.Fatalf("unexpected error message %q", err)
+	}
+}
+
+func TestOfflineModeNeedsTicksTTL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results":[{"t":1,"p":130.5}]}`)
+	}))
+	defer srv.Close()
+	day := date("2021-01-04")
+
+	for _, tc := range []struct {
+		options []func(*Client)
+		cached  bool
+	}{
+		{nil, false},
+		{[]func(*Client){WithCacheTTL(ClassTicks, time.Hour)}, true},
+	} {
+		cache := NewMemoryCache(10)
+		online := NewClient("KEY", append([]func(*Client){WithBaseURL(srv.URL), WithCache(cache)}, tc.options...)...)
+		if _, err := online.StockTrades("AAPL", day, nil); err != nil {
+			t.Fatal(err)
+		}
+		offline := NewClient("KEY", WithBaseURL(srv.URL), WithCache(cache), WithOfflineMode())
+		trades, err := offline.StockTrades("AAPL", day, nil)
+		if tc.cached && (err != nil || len(*trades) != 1) {
+			t.Fatalf("expected recorded trades, got %v", err)
+		}
+		if !tc.cached && !errors.Is(err, ErrNotCached) {
+			t.Fatalf("expected ErrNotCached without a ticks TTL, got %v", err)
+		}
+	}
+}
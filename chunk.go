@@ -0,0 +1,301 @@
+package polygonio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAggregatesLimit = 5000
+	maxAggregatesLimit     = 50000
+
+	// maxSplit bounds the number of chunks a span is split into at once,
+	// chunks that are still truncated are split again.
+	maxSplit = 64
+)
+
+// ErrTruncated is returned when a range of aggregates cannot be split any
+// further and still holds more bars than a single request returns, i.e. a
+// single millisecond.
+var ErrTruncated = errors.New("polygon: aggregates truncated at the result limit")
+
+// WithChunkConcurrency fetches up to n chunks of a StockAggregates range in
+// parallel, instead of one after the other.
+func WithChunkConcurrency(n int) func(*Client) {
+	return func(client *Client) {
+		client.chunkConcurrency = n
+	}
+}
+
+// aggregatesLimit is the number of bars a single aggregates request returns
+// at most.
+func aggregatesLimit(opts *RequestOptions) int {
+	if opts == nil || opts.Limit <= 0 {
+		return defaultAggregatesLimit
+	}
+	if opts.Limit > maxAggregatesLimit {
+		return maxAggregatesLimit
+	}
+	return int(opts.Limit)
+}
+
+// civilDay drops the time of day of t, keeping its date.
+func civilDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// aggregatesSpan is the from and to bounds of an aggregates request, both
+// inclusive. Each bound is either a date, which Polygon reads in the
+// exchange's time zone, or Unix milliseconds.
+type aggregatesSpan struct {
+	from, to             time.Time
+	fromMillis, toMillis bool
+}
+
+func (s aggregatesSpan) bounds() (string, string) {
+	from, to := s.from.Format(DateLayoutISO), s.to.Format(DateLayoutISO)
+	if s.fromMillis {
+		from = strconv.FormatInt(unixMillis(s.from), 10)
+	}
+	if s.toMillis {
+		to = strconv.FormatInt(unixMillis(s.to), 10)
+	}
+	return from, to
+}
+
+func (s aggregatesSpan) String() string {
+	from, to := s.bounds()
+	return from + "/" + to
+}
+
+// split cuts the span at instants, from lo, the first bar of a truncated
+// response, in chunks as long as covered and at most maxSplit of them. The
+// first and last chunks keep the bounds of the span, so that a date is never
+// cut at a UTC midnight that is not its session's edge. The end of a date
+// span is only estimated to place the cuts, its last chunk reaches to the
+// date whatever it covers. hi, the last bar seen, is always inside the span.
+// It returns nil when the span cannot be split any further.
+func (s aggregatesSpan) split(lo, hi int64, covered time.Duration) []aggregatesSpan {
+	end := unixMillis(s.to)
+	if !s.toMillis {
+		end = unixMillis(civilDay(s.to).AddDate(0, 0, 1)) - 1
+	}
+	if end < hi {
+		end = hi
+	}
+	size := int64(covered / time.Millisecond)
+	if size < 1 {
+		size = 1
+	}
+	if least := (end - lo + maxSplit) / maxSplit; size < least {
+		size = least
+	}
+	var chunks []aggregatesSpan
+	from, fromMillis := s.from, s.fromMillis
+	for cut := lo + size; cut <= end; cut += size {
+		chunks = append(chunks, aggregatesSpan{from: from, fromMillis: fromMillis, to: timeMillis(cut - 1), toMillis: true})
+		from, fromMillis = timeMillis(cut), true
+	}
+	if chunks == nil {
+		return nil
+	}
+	return append(chunks, aggregatesSpan{from: from, fromMillis: fromMillis, to: s.to, toMillis: s.toMillis})
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func timeMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// aggregatesChunk is the response to a single aggregates request.
+type aggregatesChunk struct {
+	bars       interface{} // Bars or IndexBars
+	times      []int64
+	queryCount int
+}
+
+// aggregatesFetch requests the bars of span, e.g. of a stock or an index.
+type aggregatesFetch func(ctx context.Context, span aggregatesSpan, opts *RequestOptions) (aggregatesChunk, error)
+
+// aggregatesRange fetches the bars of span, split in chunks when a single
+// request cannot return them all, see fetchChunks. At most chunkConcurrency
+// requests are in flight for the whole range, however deep the chunks are
+// split. The chunks are returned in order, to be joined by mergeBars or
+// mergeIndexBars.
+func (c *Client) aggregatesRange(ctx context.Context, ticker string, span aggregatesSpan, opts *RequestOptions, fetch aggregatesFetch) ([]aggregatesChunk, error) {
+	concurrency := c.chunkConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	return c.fetchChunks(ctx, sem, ticker, span, opts, fetch)
+}
+
+// fetchChunks fetches the bars of span. Polygon applies the limit to the base
+// aggregates it reads, not to the bars it returns, so the response was most
+// likely cut short when it read or returned as many as the limit: the span is
+// then split into chunks as long as the response covered and the chunks are
+// fetched instead. Chunks are read in ascending order, so that the first bar
+// of a truncated chunk is the start of its data.
+func (c *Client) fetchChunks(ctx context.Context, sem chan struct{}, ticker string, span aggregatesSpan, opts *RequestOptions, fetch aggregatesFetch) ([]aggregatesChunk, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	out, err := fetch(ctx, span, opts)
+	<-sem
+	if err != nil {
+		return nil, err
+	}
+	limit := aggregatesLimit(opts)
+	if len(out.times) == 0 || (len(out.times) < limit && out.queryCount < limit) {
+		return []aggregatesChunk{out}, nil
+	}
+
+	lo, hi := out.times[0], out.times[0]
+	for _, t := range out.times {
+		if t < lo {
+			lo = t
+		}
+		if t > hi {
+			hi = t
+		}
+	}
+	start := lo
+	if opts != nil && opts.Sort == Desc {
+		// The latest bars came back, the data may start anywhere before lo.
+		start = unixMillis(span.from)
+		if !span.fromMillis {
+			start = unixMillis(civilDay(span.from))
+		}
+		asc := *opts
+		asc.Sort = Asc
+		opts = &asc
+	}
+	chunks := span.split(start, hi, time.Duration(hi-lo)*time.Millisecond)
+	if chunks == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrTruncated, ticker, span)
+	}
+	results := make([][]aggregatesChunk, len(chunks))
+	err = c.forEachChunk(ctx, len(chunks), func(ctx context.Context, i int) error {
+		var err error
+		results[i], err = c.fetchChunks(ctx, sem, ticker, chunks[i], opts, fetch)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var all []aggregatesChunk
+	for _, r := range results {
+		all = append(all, r...)
+	}
+	return all, nil
+}
+
+// forEachChunk runs fetch for chunks 0 to n-1 and returns the first error.
+// Chunks are fetched one after the other without a chunk concurrency, else
+// all at once, their requests waiting for the semaphore of the range. Pending
+// chunks are skipped once a chunk failed.
+func (c *Client) forEachChunk(ctx context.Context, n int, fetch func(ctx context.Context, i int) error) error {
+	if c.chunkConcurrency <= 1 {
+		for i := 0; i < n; i++ {
+			if err := fetch(ctx, i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := fetch(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// barRef is a bar of a chunk, see mergeOrder.
+type barRef struct {
+	chunk, i int
+}
+
+// mergeOrder orders the bars of chunks by time, dropping the bars repeated at
+// chunk boundaries.
+func mergeOrder(chunks []aggregatesChunk, desc bool) []barRef {
+	var refs []barRef
+	for c, chunk := range chunks {
+		for i := range chunk.times {
+			refs = append(refs, barRef{c, i})
+		}
+	}
+	at := func(r barRef) int64 { return chunks[r.chunk].times[r.i] }
+	sort.SliceStable(refs, func(i, j int) bool { return at(refs[i]) < at(refs[j]) })
+	n := 0
+	for i, r := range refs {
+		if i > 0 && at(r) == at(refs[n-1]) {
+			continue
+		}
+		refs[n] = r
+		n++
+	}
+	refs = refs[:n]
+	if desc {
+		for i, j := 0, len(refs)-1; i < j; i, j = i+1, j-1 {
+			refs[i], refs[j] = refs[j], refs[i]
+		}
+	}
+	return refs
+}
+
+// mergeBars joins the bars of several chunks into one series ordered by
+// time. A single chunk is returned as is, in the order it was requested.
+func mergeBars(chunks []aggregatesChunk, desc bool) Bars {
+	if len(chunks) == 1 {
+		return chunks[0].bars.(Bars)
+	}
+	var out Bars
+	for _, r := range mergeOrder(chunks, desc) {
+		out = append(out, chunks[r.chunk].bars.(Bars)[r.i])
+	}
+	return out
+}
+
+// mergeIndexBars is mergeBars for the chunks of an index.
+func mergeIndexBars(chunks []aggregatesChunk, desc bool) IndexBars {
+	if len(chunks) == 1 {
+		return chunks[0].bars.(IndexBars)
+	}
+	var out IndexBars
+	for _, r := range mergeOrder(chunks, desc) {
+		out = append(out, chunks[r.chunk].bars.(IndexBars)[r.i])
+	}
+	return out
+}
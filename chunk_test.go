@@ -0,0 +1,244 @@
+package polygonio
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// parseBound parses an aggregates bound, a date or Unix milliseconds.
+func parseBound(s string, end bool) time.Time {
+	if d, err := time.Parse(DateLayoutISO, s); err == nil {
+		if end {
+			return d.AddDate(0, 0, 1).Add(-time.Millisecond)
+		}
+		return d
+	}
+	ms, _ := strconv.ParseInt(s, 10, 64)
+	return timeMillis(ms)
+}
+
+// minuteBars serves bars of multiplier minutes built from the first 1000
+// minutes of each day. Like Polygon, the limit parameter caps the number of
+// minutes read, not the number of bars returned.
+func minuteBars(requests *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		seg := strings.Split(r.URL.Path, "/")
+		multiplier, _ := strconv.Atoi(seg[6])
+		from, to := parseBound(seg[8], false), parseBound(seg[9], true)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit == 0 {
+			limit = defaultAggregatesLimit
+		}
+		out := StockBarsResponse{Results: Bars{}}
+		for d := civilDay(from); !d.After(to); d = d.AddDate(0, 0, 1) {
+			for i := 0; i < 1000 && int(out.QueryCount) < limit; i++ {
+				t := d.Add(time.Duration(i) * time.Minute)
+				if t.Before(from) || t.After(to) {
+					continue
+				}
+				out.QueryCount++
+				if i%multiplier == 0 {
+					out.Results = append(out.Results, Bar{Time: unixMillis(t)})
+				}
+			}
+		}
+		out.ResultsCount = int32(len(out.Results))
+		json.NewEncoder(w).Encode(out)
+	})
+}
+
+func TestStockAggregatesChunksTruncatedRanges(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(minuteBars(&requests))
+	defer srv.Close()
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 9)
+	for _, concurrency := range []int{1, 4} {
+		client := NewClient("KEY", WithBaseURL(srv.URL), WithChunkConcurrency(concurrency))
+		bars, err := client.StockAggregates("AAPL", 1, Minute, from, to, &RequestOptions{Limit: 3000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(*bars) != 10000 {
+			t.Fatalf("expected 10000 bars, got %d", len(*bars))
+		}
+		for i := 1; i < len(*bars); i++ {
+			if (*bars)[i].Time <= (*bars)[i-1].Time {
+				t.Fatalf("bars out of order at %d", i)
+			}
+		}
+	}
+
+	// a single truncated day is split by milliseconds
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+	bars, err := client.StockAggregates("AAPL", 1, Minute, from, from, &RequestOptions{Limit: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bars) != 1000 {
+		t.Fatalf("expected the 1000 bars of the day, got %d", len(*bars))
+	}
+
+	// with a multiplier the response is cut short well below limit bars
+	bars, err = client.StockAggregates("AAPL", 5, Minute, from, to, &RequestOptions{Limit: 3000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bars) != 2000 {
+		t.Fatalf("expected 2000 bars, got %d", len(*bars))
+	}
+
+	// a single millisecond cannot be split at all
+	tick := func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(StockBarsResponse{QueryCount: 1, Results: Bars{{Time: unixMillis(from)}}})
+	}
+	srv1 := httptest.NewServer(http.HandlerFunc(tick))
+	defer srv1.Close()
+	client = NewClient("KEY", WithBaseURL(srv1.URL))
+	if _, err := client.StockAggregatesMillis("AAPL", 1, Minute, from, from, &RequestOptions{Limit: 1}); !errors.Is(err, ErrTruncated) {
+		t.Fatalf("expected ErrTruncated, got %v", err)
+	}
+}
+
+// sessionBars serves the bars at times, reading date bounds in the UTC-5 zone
+// of the exchange like Polygon does. It records the most requests it saw in
+// flight at once.
+func sessionBars(times []int64, maxInFlight *int32) http.Handler {
+	et := time.FixedZone("ET", -5*3600)
+	var inFlight int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for m := atomic.LoadInt32(maxInFlight); n > m && !atomic.CompareAndSwapInt32(maxInFlight, m, n); m = atomic.LoadInt32(maxInFlight) {
+		}
+		time.Sleep(time.Millisecond)
+
+		bound := func(s string, end bool) int64 {
+			d, err := time.ParseInLocation(DateLayoutISO, s, et)
+			if err != nil {
+				ms, _ := strconv.ParseInt(s, 10, 64)
+				return ms
+			}
+			if end {
+				d = d.AddDate(0, 0, 1).Add(-time.Millisecond)
+			}
+			return unixMillis(d)
+		}
+		seg := strings.Split(r.URL.Path, "/")
+		from, to := bound(seg[8], false), bound(seg[9], true)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		out := StockBarsResponse{Results: Bars{}}
+		for _, t := range times {
+			if t >= from && t <= to && len(out.Results) < limit {
+				out.Results = append(out.Results, Bar{Time: t})
+			}
+		}
+		if r.URL.Query().Get("sort") == string(Desc) {
+			sort.Slice(out.Results, func(i, j int) bool { return out.Results[i].Time > out.Results[j].Time })
+		}
+		out.QueryCount = int32(len(out.Results))
+		out.ResultsCount = out.QueryCount
+		json.NewEncoder(w).Encode(out)
+	})
+}
+
+func TestStockAggregatesSplitsOnInstants(t *testing.T) {
+	// the extended hours of 2021-01-04 ET, 04:00 to 20:00, run past UTC midnight
+	day := time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+	var times []int64
+	for m := 9 * 60; m < 25*60; m++ {
+		times = append(times, unixMillis(day.Add(time.Duration(m)*time.Minute)))
+	}
+	var maxInFlight int32
+	srv := httptest.NewServer(sessionBars(times, &maxInFlight))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+	for _, sort := range []Sort{Asc, Desc} {
+		bars, err := client.StockAggregates("AAPL", 1, Minute, day, day, &RequestOptions{Limit: 500, Sort: sort})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(*bars) != len(times) {
+			t.Fatalf("%s: expected the %d bars of the session, got %d", sort, len(times), len(*bars))
+		}
+		first, last := (*bars)[0].Time, (*bars)[len(*bars)-1].Time
+		if sort == Desc {
+			first, last = last, first
+		}
+		if first != times[0] || last != times[len(times)-1] {
+			t.Fatalf("%s: expected bars from %d to %d, got %d to %d", sort, times[0], times[len(times)-1], first, last)
+		}
+	}
+
+	// sparse hours then dense minutes split again and again, yet never more
+	// requests than the chunk concurrency are in flight
+	times = nil
+	for h := 0; h < 5*24; h++ {
+		times = append(times, unixMillis(day.Add(time.Duration(h)*time.Hour)))
+	}
+	dense := day.AddDate(0, 0, 5)
+	for m := 0; m < 1000; m++ {
+		times = append(times, unixMillis(dense.Add(time.Duration(m)*time.Minute)))
+	}
+	srv2 := httptest.NewServer(sessionBars(times, &maxInFlight))
+	defer srv2.Close()
+	maxInFlight = 0
+	client = NewClient("KEY", WithBaseURL(srv2.URL), WithChunkConcurrency(2))
+	bars, err := client.StockAggregatesMillis("AAPL", 1, Minute, day, dense.Add(24*time.Hour), &RequestOptions{Limit: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bars) != len(times) {
+		t.Fatalf("expected %d bars, got %d", len(times), len(*bars))
+	}
+	if n := atomic.LoadInt32(&maxInFlight); n > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", n)
+	}
+}
+
+func TestStockAggregatesMillis(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(minuteBars(&requests))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	open := time.Date(2021, 1, 4, 9, 30, 0, 0, time.UTC)
+	bars, err := client.StockAggregatesMillis("AAPL", 1, Minute, open, open.Add(30*time.Minute), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bars) != 31 || (*bars)[0].Time != unixMillis(open) {
+		t.Fatalf("expected the 31 bars from 09:30 to 10:00, got %d", len(*bars))
+	}
+
+	// unlike whole days, millisecond spans can always be split further
+	day := civilDay(open)
+	bars, err = client.StockAggregatesMillis("AAPL", 1, Minute, day, day.Add(24*time.Hour-time.Millisecond), &RequestOptions{Limit: 300})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*bars) != 1000 {
+		t.Fatalf("expected 1000 bars, got %d", len(*bars))
+	}
+}
+
+func TestMergeBars(t *testing.T) {
+	chunks := []aggregatesChunk{
+		{bars: Bars{{Time: 1}, {Time: 2}}, times: []int64{1, 2}},
+		{bars: Bars{{Time: 2}, {Time: 3}}, times: []int64{2, 3}},
+	}
+	merged := mergeBars(chunks, true)
+	if len(merged) != 3 || merged[0].Time != 3 || merged[2].Time != 1 {
+		t.Fatalf("unexpected merge %+v", merged)
+	}
+}
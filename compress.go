@@ -0,0 +1,91 @@
+package polygonio
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// TransferStats reports how many response bytes the Client has received.
+type TransferStats struct {
+	WireBytes    int64 // bytes read from the network, compressed when the server used gzip
+	DecodedBytes int64 // bytes after decompression
+}
+
+type transferCounters struct {
+	wire, decoded int64
+}
+
+// WithoutCompression stops the Client from asking for gzip encoded
+// responses.
+func WithoutCompression() func(*Client) {
+	return func(client *Client) {
+		client.noCompression = true
+	}
+}
+
+// TransferStats returns the bytes received by the Client so far.
+func (c *Client) TransferStats() TransferStats {
+	return TransferStats{
+		WireBytes:    atomic.LoadInt64(&c.stats.wire),
+		DecodedBytes: atomic.LoadInt64(&c.stats.decoded),
+	}
+}
+
+// acceptGzip asks for a compressed response. Setting the header ourselves
+// turns off the transparent decompression of http.Transport, so decodeBody
+// behaves the same whatever RoundTripper a custom http.Client uses.
+func (c *Client) acceptGzip(req *http.Request) {
+	if !c.noCompression {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
+// decodeBody returns the decompressed body of resp and keeps the transfer
+// stats up to date while it is read.
+func (c *Client) decodeBody(resp *http.Response) (io.ReadCloser, error) {
+	wire := &countingReader{r: resp.Body, n: &c.stats.wire}
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return &countingReadCloser{
+			countingReader: countingReader{r: wire, n: &c.stats.decoded},
+			closers:        []io.Closer{resp.Body},
+		}, nil
+	}
+	zr, err := gzip.NewReader(wire)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return &countingReadCloser{
+		countingReader: countingReader{r: zr, n: &c.stats.decoded},
+		closers:        []io.Closer{zr, resp.Body},
+	}, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	atomic.AddInt64(cr.n, int64(n))
+	return n, err
+}
+
+type countingReadCloser struct {
+	countingReader
+	closers []io.Closer
+}
+
+func (cr *countingReadCloser) Close() error {
+	var err error
+	for _, c := range cr.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net/url"
 	"time"
+
+	"github.com/google/go-querystring/query"
+	ej "github.com/mailru/easyjson"
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -14,33 +17,125 @@ import (
 ////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////
 func (c *Client) CryptoExchanges() (Exchanges, error) {
+	return c.CryptoExchangesContext(context.Background())
+}
+
+func (c *Client) CryptoExchangesContext(ctx context.Context) (Exchanges, error) {
 	var out Exchanges
 	endpoint := fmt.Sprintf("/v1/meta/crypto-exchanges")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
 func (c *Client) CryptoPreviousClose(ticker string, opts *RequestOptions) (*Bars, error) {
-	return c.StockPreviousClose(ticker, opts)
+	return c.CryptoPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) CryptoPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error) {
+	return c.StockPreviousCloseContext(ctx, ticker, opts)
 }
 
 func (c *Client) CryptoAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
-	return c.StockAggregates(ticker, multiplier, timespan, from, to, opts)
+	return c.CryptoAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (c *Client) CryptoAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockAggregatesContext(ctx, ticker, multiplier, timespan, from, to, opts)
 }
 
 func (c *Client) CryptoGroupedDaily(locale Locale, date time.Time, opts *RequestOptions) (*Bars, error) {
-	return c.StockGroupedDaily(locale, Crypto, date, opts)
+	return c.CryptoGroupedDailyContext(context.Background(), locale, date, opts)
 }
 
-func (c *Client) CryptoLastTradeForCryptoPair() {}
+func (c *Client) CryptoGroupedDailyContext(ctx context.Context, locale Locale, date time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockGroupedDailyContext(ctx, locale, Crypto, date, opts)
+}
+
+func (c *Client) CryptoLastTradeForCryptoPair(pair CurrencyPair) (CryptoLastTrade, error) {
+	return c.CryptoLastTradeForCryptoPairContext(context.Background(), pair)
+}
+
+func (c *Client) CryptoLastTradeForCryptoPairContext(ctx context.Context, pair CurrencyPair) (CryptoLastTrade, error) {
+	out := struct {
+		Last CryptoLastTrade `json:"last"`
+	}{}
+	endpoint := fmt.Sprintf("/v1/last/crypto/%s/%s", url.PathEscape(pair.From), url.PathEscape(pair.To))
+	err := c.GetJSON(ctx, endpoint, &out)
+	return out.Last, err
+}
 
 func (c *Client) CryptoDaily(from, to, date string) (CryptoDaily, error) {
+	return c.CryptoDailyContext(context.Background(), from, to, date)
+}
+
+func (c *Client) CryptoDailyContext(ctx context.Context, from, to, date string) (CryptoDaily, error) {
 	var out CryptoDaily
 	endpoint := fmt.Sprintf("/v1/open-close/crypto/%s/%s/%s", url.PathEscape(from), url.PathEscape(to), url.PathEscape(date))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
-func (c *Client) CryptoHistoricTrades()   {}
-func (c *Client) CryptoSnapshotAll()      {}
-func (c *Client) CryptoSnapshotFullBook() {}
+// CryptoHistoricTrades returns the trades of pair on date, starting at
+// opts.Offset. Pass the time of the last trade as the next Offset to page
+// through the day. The offset is inclusive, so each page starts again with
+// the trades at that time, which the previous page already returned and the
+// caller must skip.
+func (c *Client) CryptoHistoricTrades(pair CurrencyPair, date time.Time, opts *CryptoTradesOptions) (*CryptoTrades, error) {
+	return c.CryptoHistoricTradesContext(context.Background(), pair, date, opts)
+}
+
+func (c *Client) CryptoHistoricTradesContext(ctx context.Context, pair CurrencyPair, date time.Time, opts *CryptoTradesOptions) (*CryptoTrades, error) {
+	var out CryptoHistoricTradesResponse
+	endpoint := fmt.Sprintf("/v1/historic/crypto/%s/%s/%s", url.PathEscape(pair.From), url.PathEscape(pair.To), url.PathEscape(date.Format(DateLayoutISO)))
+	endpoint, err := c.cryptoTradesWithOpts(endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = c.GetJSON(ctx, endpoint, &out)
+	return &out.Ticks, err
+}
+
+func (c *Client) cryptoTradesWithOpts(endpoint string, opts *CryptoTradesOptions) (string, error) {
+	if opts == nil {
+		return endpoint, nil
+	}
+	v, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	optParams := v.Encode()
+	if optParams != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, optParams)
+	}
+	return endpoint, nil
+}
+
+func (c *Client) CryptoSnapshotAll() (*CryptoSnapshots, error) {
+	return c.CryptoSnapshotAllContext(context.Background())
+}
+
+func (c *Client) CryptoSnapshotAllContext(ctx context.Context) (*CryptoSnapshots, error) {
+	var out CryptoSnapshotsResponse
+	endpoint := fmt.Sprintf("/v2/snapshot/locale/global/markets/crypto/tickers")
+	bts, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	err = ej.Unmarshal(bts, &out)
+	return &out.Results, err
+}
+
+// CryptoSnapshotFullBook returns the level 2 book of a crypto ticker such as
+// X:BTCUSD across all exchanges.
+func (c *Client) CryptoSnapshotFullBook(ticker string) (CryptoBook, error) {
+	return c.CryptoSnapshotFullBookContext(context.Background(), ticker)
+}
+
+func (c *Client) CryptoSnapshotFullBookContext(ctx context.Context, ticker string) (CryptoBook, error) {
+	out := struct {
+		Data CryptoBook `json:"data"`
+	}{}
+	endpoint := fmt.Sprintf("/v2/snapshot/locale/global/markets/crypto/tickers/%s/book", url.PathEscape(ticker))
+	err := c.GetJSON(ctx, endpoint, &out)
+	return out.Data, err
+}
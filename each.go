@@ -0,0 +1,139 @@
+package polygonio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// The Each methods decode results one element at a time straight from the
+// response body and pass them to fn, instead of holding the whole response
+// in memory. Iteration stops at the first error returned by fn, which is
+// then returned to the caller.
+
+func (c *Client) StockAggregatesEach(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions, fn func(Bar) error) error {
+	endpoint := fmt.Sprintf("/v2/aggs/ticker/%s/range/%s/%s/%s/%s", url.PathEscape(ticker), url.PathEscape(strconv.Itoa(int(multiplier))), url.PathEscape(string(timespan)), url.PathEscape(from.Format(DateLayoutISO)), url.PathEscape(to.Format(DateLayoutISO)))
+	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	if err != nil {
+		return err
+	}
+	return c.getEach(ctx, endpoint, "results", func(dec *json.Decoder) error {
+		var bar Bar
+		if err := dec.Decode(&bar); err != nil {
+			return err
+		}
+		return fn(bar)
+	})
+}
+
+func (c *Client) StockTradesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Trade) error) error {
+	endpoint := fmt.Sprintf("/v2/ticks/stocks/trades/%s/%s", url.PathEscape(ticker), url.PathEscape(date.Format(DateLayoutISO)))
+	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	if err != nil {
+		return err
+	}
+	return c.getEach(ctx, endpoint, "results", func(dec *json.Decoder) error {
+		var trade Trade
+		if err := dec.Decode(&trade); err != nil {
+			return err
+		}
+		return fn(trade)
+	})
+}
+
+func (c *Client) StockQuotesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Quote) error) error {
+	endpoint := fmt.Sprintf("/v2/ticks/stocks/nbbo/%s/%s", url.PathEscape(ticker), url.PathEscape(date.Format(DateLayoutISO)))
+	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	if err != nil {
+		return err
+	}
+	return c.getEach(ctx, endpoint, "results", func(dec *json.Decoder) error {
+		var quote Quote
+		if err := dec.Decode(&quote); err != nil {
+			return err
+		}
+		return fn(quote)
+	})
+}
+
+func (c *Client) StockSnapshotAllEach(ctx context.Context, fn func(Snapshot) error) error {
+	endpoint := fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/tickers")
+	return c.getEach(ctx, endpoint, "tickers", func(dec *json.Decoder) error {
+		var snapshot Snapshot
+		if err := dec.Decode(&snapshot); err != nil {
+			return err
+		}
+		return fn(snapshot)
+	})
+}
+
+// getEach requests endpoint and calls next once per element of the array
+// stored under field in the top level response object. next must consume
+// exactly one value from the decoder.
+func (c *Client) getEach(ctx context.Context, endpoint, field string, next func(*json.Decoder) error) error {
+	address, err := c.addToken(endpoint)
+	if err != nil {
+		return err
+	}
+	body, err := c.getBody(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return decodeEach(body, field, next)
+}
+
+func decodeEach(r io.Reader, field string, next func(*json.Decoder) error) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := tok.(string); key != field {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok == nil {
+			// results are null when nothing matched
+			continue
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return fmt.Errorf("polygon: expected array for %q, got %v", field, tok)
+		}
+		for dec.More() {
+			if err := next(dec); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("polygon: expected %v in response, got %v", delim, tok)
+	}
+	return nil
+}
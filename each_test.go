@@ -0,0 +1,61 @@
+package polygonio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDecodeEach(t *testing.T) {
+	body := `{"ticker":"AAPL","extra":{"a":[1,2]},"results":[{"t":1,"p":1.5},{"t":2,"p":2.5}],"status":"OK"}`
+	var got []Trade
+	err := decodeEach(strings.NewReader(body), "results", func(dec *json.Decoder) error {
+		var trade Trade
+		if err := dec.Decode(&trade); err != nil {
+			return err
+		}
+		got = append(got, trade)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[1].SIPTime != 2 || got[1].Price != 2.5 {
+		t.Fatalf("unexpected trades %+v", got)
+	}
+
+	calls := 0
+	err = decodeEach(strings.NewReader(`{"results":null}`), "results", func(dec *json.Decoder) error {
+		calls++
+		return nil
+	})
+	if err != nil || calls != 0 {
+		t.Fatalf("expected no results, got %d calls and %v", calls, err)
+	}
+}
+
+func TestStockTradesEachStopsOnCallbackError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results":[{"t":1},{"t":2},{"t":3}]}`)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	stop := errors.New("stop")
+	n := 0
+	err := client.StockTradesEach(context.Background(), "AAPL", date("2020-10-05"), nil, func(trade Trade) error {
+		n++
+		if trade.SIPTime == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 2 {
+		t.Fatalf("expected to stop after 2 trades, got %d and %v", n, err)
+	}
+}
@@ -0,0 +1,100 @@
+package polygonio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrRateLimited   = errors.New("polygon: rate limited")
+	ErrNotFound      = errors.New("polygon: not found")
+	ErrNotAuthorized = errors.New("polygon: not authorized")
+	ErrNotCached     = errors.New("polygon: response not cached")
+)
+
+// Error is returned for every failed request. StatusCode is zero when the
+// request never got a response, in which case Err holds the transport error.
+//
+// Error matches ErrRateLimited, ErrNotFound and ErrNotAuthorized with
+// errors.Is according to its status code.
+type Error struct {
+	StatusCode int    `json:"code"`
+	Status     string `json:"status"`     // status field of the error body, e.g. "ERROR" or "NOT_FOUND"
+	Message    string `json:"message"`    // error or message field of the body, the raw body if it is not JSON
+	RequestID  string `json:"request_id"` // quote this when contacting Polygon support
+	Endpoint   string `json:"endpoint"`   // request path and query, with the API key redacted
+	Attempts   int    `json:"-"`          // number of attempts made, including retries
+	Err        error  `json:"-"`
+}
+
+func (e Error) Error() string {
+	msg := fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	switch {
+	case e.Err == nil:
+	case e.StatusCode == 0:
+		msg = e.Err.Error()
+	case e.Message == "":
+		msg += e.Err.Error()
+	default:
+		msg += ": " + e.Err.Error()
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request_id %s)", msg, e.RequestID)
+	}
+	if e.Attempts > 1 {
+		msg = fmt.Sprintf("%s (after %d attempts)", msg, e.Attempts)
+	}
+	return msg
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrNotAuthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+func IsNotAuthorized(err error) bool {
+	return errors.Is(err, ErrNotAuthorized)
+}
+
+// newError builds an Error from a non 200 response and its (redacted) body.
+func newError(resp *http.Response, body string) *Error {
+	e := &Error{StatusCode: resp.StatusCode, Message: body}
+	out := struct {
+		Status    string `json:"status"`
+		RequestID string `json:"request_id"`
+		Error     string `json:"error"`
+		Message   string `json:"message"`
+	}{}
+	if err := json.Unmarshal([]byte(body), &out); err != nil {
+		return e
+	}
+	e.Status = out.Status
+	e.RequestID = out.RequestID
+	if out.Error != "" {
+		e.Message = out.Error
+	} else if out.Message != "" {
+		e.Message = out.Message
+	}
+	return e
+}
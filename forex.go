@@ -1,6 +1,51 @@
 package polygonio
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	ej "github.com/mailru/easyjson"
+)
+
+// CurrencyPair is a pair of currencies such as EUR/USD, quoted as the price of
+// From in To.
+type CurrencyPair struct {
+	From string
+	To   string
+}
+
+// ParseCurrencyPair parses a pair written as "EUR/USD", "EUR-USD", "EURUSD"
+// or as the aggregates ticker "C:EURUSD".
+func ParseCurrencyPair(s string) (CurrencyPair, error) {
+	code := strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(s, "C:"), "X:"))
+	if i := strings.IndexAny(code, "/-"); i >= 0 {
+		if i > 0 && i < len(code)-1 {
+			return CurrencyPair{From: code[:i], To: code[i+1:]}, nil
+		}
+	} else if len(code) == 6 {
+		return CurrencyPair{From: code[:3], To: code[3:]}, nil
+	}
+	return CurrencyPair{}, fmt.Errorf("polygon: invalid currency pair %q", s)
+}
+
+func (p CurrencyPair) String() string {
+	return p.From + "/" + p.To
+}
+
+// ForexTicker returns the ticker of the pair for the aggregates and snapshot
+// endpoints, e.g. C:EURUSD.
+func (p CurrencyPair) ForexTicker() string {
+	return "C:" + p.From + p.To
+}
+
+// CryptoTicker returns the ticker of a crypto pair, e.g. X:BTCUSD.
+func (p CurrencyPair) CryptoTicker() string {
+	return "X:" + p.From + p.To
+}
 
 ////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////
@@ -9,19 +54,131 @@ import "time"
 ////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////
 func (c *Client) ForexPreviousClose(ticker string, opts *RequestOptions) (*Bars, error) {
-	return c.StockPreviousClose(ticker, nil)
+	return c.ForexPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) ForexPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error) {
+	return c.StockPreviousCloseContext(ctx, ticker, opts)
 }
 
 func (c *Client) ForexAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
-	return c.StockAggregates(ticker, multiplier, timespan, from, to, nil)
+	return c.ForexAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (c *Client) ForexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockAggregatesContext(ctx, ticker, multiplier, timespan, from, to, opts)
 }
 
 func (c *Client) ForexGroupedDaily(locale Locale, date time.Time, opts *RequestOptions) (*Bars, error) {
-	return c.StockGroupedDaily(locale, FX, date, opts)
+	return c.ForexGroupedDailyContext(context.Background(), locale, date, opts)
+}
+
+func (c *Client) ForexGroupedDailyContext(ctx context.Context, locale Locale, date time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockGroupedDailyContext(ctx, locale, FX, date, opts)
+}
+
+// ForexHistoricTicks returns the quotes of pair on date, starting at
+// opts.Offset. Pass the time of the last tick as the next Offset to page
+// through the day. The offset is inclusive, so each page starts again with
+// the ticks at that time, which the previous page already returned and the
+// caller must skip.
+func (c *Client) ForexHistoricTicks(pair CurrencyPair, date time.Time, opts *ForexTicksOptions) (*ForexTicks, error) {
+	return c.ForexHistoricTicksContext(context.Background(), pair, date, opts)
+}
+
+func (c *Client) ForexHistoricTicksContext(ctx context.Context, pair CurrencyPair, date time.Time, opts *ForexTicksOptions) (*ForexTicks, error) {
+	var out ForexHistoricTicksResponse
+	endpoint := fmt.Sprintf("/v1/historic/forex/%s/%s/%s", url.PathEscape(pair.From), url.PathEscape(pair.To), url.PathEscape(date.Format(DateLayoutISO)))
+	endpoint, err := c.forexTicksWithOpts(endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = c.GetJSON(ctx, endpoint, &out)
+	return &out.Ticks, err
 }
 
-func (c *Client) ForexHistoricTicks()             {}
-func (c *Client) ForexRealTimeConversion()        {}
-func (c *Client) ForexLastQuotesForCurrencyPair() {}
-func (c *Client) ForexSnapshotAll()               {}
-func (c *Client) ForexSnapshotTopGainersLosers()  {}
+func (c *Client) forexTicksWithOpts(endpoint string, opts *ForexTicksOptions) (string, error) {
+	if opts == nil {
+		return endpoint, nil
+	}
+	v, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	optParams := v.Encode()
+	if optParams != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, optParams)
+	}
+	return endpoint, nil
+}
+
+// ForexRealTimeConversion converts opts.Amount of pair.From into pair.To at
+// the last quote, rounded to opts.Precision digits.
+func (c *Client) ForexRealTimeConversion(pair CurrencyPair, opts *ConversionOptions) (ForexConversion, error) {
+	return c.ForexRealTimeConversionContext(context.Background(), pair, opts)
+}
+
+func (c *Client) ForexRealTimeConversionContext(ctx context.Context, pair CurrencyPair, opts *ConversionOptions) (ForexConversion, error) {
+	var out ForexConversion
+	endpoint := fmt.Sprintf("/v1/conversion/%s/%s", url.PathEscape(pair.From), url.PathEscape(pair.To))
+	endpoint, err := c.conversionWithOpts(endpoint, opts)
+	if err != nil {
+		return out, err
+	}
+	err = c.GetJSON(ctx, endpoint, &out)
+	return out, err
+}
+
+func (c *Client) conversionWithOpts(endpoint string, opts *ConversionOptions) (string, error) {
+	if opts == nil {
+		return endpoint, nil
+	}
+	v, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	optParams := v.Encode()
+	if optParams != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, optParams)
+	}
+	return endpoint, nil
+}
+
+func (c *Client) ForexLastQuotesForCurrencyPair(pair CurrencyPair) (ForexQuote, error) {
+	return c.ForexLastQuotesForCurrencyPairContext(context.Background(), pair)
+}
+
+func (c *Client) ForexLastQuotesForCurrencyPairContext(ctx context.Context, pair CurrencyPair) (ForexQuote, error) {
+	out := struct {
+		Last ForexQuote `json:"last"`
+	}{}
+	endpoint := fmt.Sprintf("/v1/last_quote/currencies/%s/%s", url.PathEscape(pair.From), url.PathEscape(pair.To))
+	err := c.GetJSON(ctx, endpoint, &out)
+	return out.Last, err
+}
+
+func (c *Client) ForexSnapshotAll() (*ForexSnapshots, error) {
+	return c.ForexSnapshotAllContext(context.Background())
+}
+
+func (c *Client) ForexSnapshotAllContext(ctx context.Context) (*ForexSnapshots, error) {
+	return c.forexSnapshots(ctx, "/v2/snapshot/locale/global/markets/forex/tickers")
+}
+
+func (c *Client) ForexSnapshotTopGainersLosers(direction Direction) (*ForexSnapshots, error) {
+	return c.ForexSnapshotTopGainersLosersContext(context.Background(), direction)
+}
+
+func (c *Client) ForexSnapshotTopGainersLosersContext(ctx context.Context, direction Direction) (*ForexSnapshots, error) {
+	return c.forexSnapshots(ctx, fmt.Sprintf("/v2/snapshot/locale/global/markets/forex/%s", url.PathEscape(string(direction))))
+}
+
+func (c *Client) forexSnapshots(ctx context.Context, endpoint string) (*ForexSnapshots, error) {
+	var out ForexSnapshotsResponse
+	bts, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	err = ej.Unmarshal(bts, &out)
+	return &out.Results, err
+}
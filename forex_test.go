@@ -0,0 +1,54 @@
+package polygonio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseCurrencyPair(t *testing.T) {
+	for _, s := range []string{"EUR/USD", "eur-usd", "EURUSD", "C:EURUSD"} {
+		pair, err := ParseCurrencyPair(s)
+		if err != nil || pair != (CurrencyPair{From: "EUR", To: "USD"}) {
+			t.Errorf("%s: unexpected pair %v, %v", s, pair, err)
+		}
+	}
+	for _, s := range []string{"", "EUR", "EUR/", "EURUSDX"} {
+		if _, err := ParseCurrencyPair(s); err == nil {
+			t.Errorf("%s: expected an error", s)
+		}
+	}
+	if ticker := (CurrencyPair{From: "EUR", To: "USD"}).ForexTicker(); ticker != "C:EURUSD" {
+		t.Errorf("unexpected ticker %s", ticker)
+	}
+}
+
+func TestForexHonorsOptions(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.Query().Get("sort")+r.URL.Query().Get("precision"))
+		fmt.Fprint(w, `{"results":[],"converted":91.25,"last":{"ask":0.9125}}`)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	if _, err := client.ForexPreviousClose("C:EURUSD", &RequestOptions{Sort: Desc}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ForexAggregates("C:EURUSD", 1, Day, date("2021-01-04"), date("2021-01-05"), &RequestOptions{Sort: Desc}); err != nil {
+		t.Fatal(err)
+	}
+	conv, err := client.ForexRealTimeConversion(CurrencyPair{From: "USD", To: "EUR"}, &ConversionOptions{Amount: 100, Precision: 2})
+	if err != nil || conv.Converted != 91.25 || conv.Last.Ask != 0.9125 {
+		t.Fatalf("unexpected conversion %+v, %v", conv, err)
+	}
+	want := []string{
+		"/v2/aggs/ticker/C:EURUSD/prev?desc",
+		"/v2/aggs/ticker/C:EURUSD/range/1/day/2021-01-04/2021-01-05?desc",
+		"/v1/conversion/USD/EUR?2",
+	}
+	if fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Fatalf("expected requests %v, got %v", want, queries)
+	}
+}
@@ -0,0 +1,113 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	ej "github.com/mailru/easyjson"
+)
+
+// IndexTicker returns the Polygon ticker of an index, e.g. I:SPX for SPX.
+// Tickers that already have the I: prefix are returned as is.
+func IndexTicker(symbol string) string {
+	if strings.HasPrefix(symbol, "I:") {
+		return symbol
+	}
+	return "I:" + symbol
+}
+
+// IndexPreviousClose returns the previous day bar of an index, ticker being
+// either I:SPX or SPX.
+func (c *Client) IndexPreviousClose(ticker string, opts *RequestOptions) (*IndexBars, error) {
+	return c.IndexPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) IndexPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*IndexBars, error) {
+	endpoint := fmt.Sprintf("/v2/aggs/ticker/%s/prev", url.PathEscape(IndexTicker(ticker)))
+	return c.indexBars(ctx, endpoint, opts)
+}
+
+// IndexAggregates returns the bars of an index between the from and to
+// dates. Like StockAggregates, ranges holding more bars than the limit of a
+// single request are fetched in chunks.
+func (c *Client) IndexAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*IndexBars, error) {
+	return c.IndexAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (c *Client) IndexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*IndexBars, error) {
+	ticker = IndexTicker(ticker)
+	fetch := func(ctx context.Context, span aggregatesSpan, opts *RequestOptions) (aggregatesChunk, error) {
+		var out IndexBarsResponse
+		if err := c.aggregates(ctx, ticker, multiplier, timespan, span, opts, &out); err != nil {
+			return aggregatesChunk{}, err
+		}
+		times := make([]int64, len(out.Results))
+		for i, b := range out.Results {
+			times[i] = b.Time
+		}
+		return aggregatesChunk{bars: out.Results, times: times, queryCount: int(out.QueryCount)}, nil
+	}
+	chunks, err := c.aggregatesRange(ctx, ticker, aggregatesSpan{from: from, to: to}, opts, fetch)
+	if err != nil {
+		return nil, err
+	}
+	bars := mergeIndexBars(chunks, opts != nil && opts.Sort == Desc)
+	return &bars, nil
+}
+
+func (c *Client) indexBars(ctx context.Context, endpoint string, opts *RequestOptions) (*IndexBars, error) {
+	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	bts, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var out IndexBarsResponse
+	if err = ej.Unmarshal(bts, &out); err != nil {
+		return nil, err
+	}
+	return &out.Results, nil
+}
+
+// IndexSnapshot returns the latest value of each of tickers, e.g. I:SPX or
+// SPX. Unknown tickers get a snapshot with Error set rather than failing the
+// call.
+func (c *Client) IndexSnapshot(tickers ...string) (IndexSnapshots, error) {
+	return c.IndexSnapshotContext(context.Background(), tickers...)
+}
+
+func (c *Client) IndexSnapshotContext(ctx context.Context, tickers ...string) (IndexSnapshots, error) {
+	symbols := make([]string, len(tickers))
+	for i, t := range tickers {
+		symbols[i] = IndexTicker(t)
+	}
+	endpoint := "/v3/snapshot/indices"
+	if len(symbols) > 0 {
+		endpoint += "?" + url.Values{"ticker.any_of": {strings.Join(symbols, ",")}}.Encode()
+	}
+	bts, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var out IndexSnapshotsResponse
+	err = ej.Unmarshal(bts, &out)
+	return out.Results, err
+}
+
+// IndexValueChannel returns the stream channel of the values of an index,
+// e.g. V.I:SPX, see ParseStreamIndexValues. Index channels are served by
+// the indices cluster, wss://socket.polygon.io/indices.
+func IndexValueChannel(ticker string) string {
+	return "V." + IndexTicker(ticker)
+}
+
+// IndexAggregateChannel returns the stream channel of the minute aggregates
+// of an index, e.g. AM.I:SPX, see ParseStreamIndexAggregates.
+func IndexAggregateChannel(ticker string) string {
+	return "AM." + IndexTicker(ticker)
+}
@@ -0,0 +1,71 @@
+package polygonio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIndexTicker(t *testing.T) {
+	for _, s := range []string{"SPX", "I:SPX"} {
+		if ticker := IndexTicker(s); ticker != "I:SPX" {
+			t.Errorf("%s: unexpected ticker %s", s, ticker)
+		}
+	}
+	if ch := IndexValueChannel("SPX"); ch != "V.I:SPX" {
+		t.Errorf("unexpected channel %s", ch)
+	}
+	if ch := IndexAggregateChannel("I:NDX"); ch != "AM.I:NDX" {
+		t.Errorf("unexpected channel %s", ch)
+	}
+}
+
+func TestIndexEndpoints(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.Query().Get("ticker.any_of"))
+		if r.URL.Path == "/v3/snapshot/indices" {
+			fmt.Fprint(w, `{"results":[{"ticker":"I:SPX","value":4512.34,"session":{"change":12.5}},{"ticker":"I:NOPE","error":"NOT_FOUND"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"results":[{"T":"I:SPX","t":1609718400000,"o":3764.61,"h":3769.99,"l":3662.71,"c":3700.65}]}`)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	bars, err := client.IndexAggregates("SPX", 1, Day, date("2021-01-04"), date("2021-01-04"), nil)
+	if err != nil || len(*bars) != 1 || (*bars)[0].Close != 3700.65 {
+		t.Fatalf("unexpected bars %+v, %v", bars, err)
+	}
+	if _, err := client.IndexPreviousClose("I:SPX", nil); err != nil {
+		t.Fatal(err)
+	}
+	snapshots, err := client.IndexSnapshot("SPX", "NOPE")
+	if err != nil || len(snapshots) != 2 || snapshots[0].Value != 4512.34 || snapshots[1].Error != "NOT_FOUND" {
+		t.Fatalf("unexpected snapshots %+v, %v", snapshots, err)
+	}
+	want := []string{
+		"/v2/aggs/ticker/I:SPX/range/1/day/2021-01-04/2021-01-04?",
+		"/v2/aggs/ticker/I:SPX/prev?",
+		"/v3/snapshot/indices?I:SPX,I:NOPE",
+	}
+	if fmt.Sprint(paths) != fmt.Sprint(want) {
+		t.Fatalf("expected requests %v, got %v", want, paths)
+	}
+}
+
+func TestParseStreamIndices(t *testing.T) {
+	values, err := ParseStreamIndexValues([]byte(`[{"ev":"V","val":4512.34,"T":"I:SPX","t":1633024800000}]`))
+	if err != nil || len(values) != 1 || values[0].Ticker != "I:SPX" || values[0].Value != 4512.34 {
+		t.Fatalf("unexpected values %+v, %v", values, err)
+	}
+	aggs, err := ParseStreamIndexAggregates([]byte(`[{"ev":"AM","sym":"I:SPX","op":4500.1,"o":4510,"c":4512.34,"h":4513,"l":4509.5,"s":1633024740000,"e":1633024800000}]`))
+	if err != nil || len(aggs) != 1 || aggs[0].Symbol != "I:SPX" || aggs[0].ClosePrice != 4512.34 {
+		t.Fatalf("unexpected aggregates %+v, %v", aggs, err)
+	}
+	events, err := ParseEvents([]byte(`[{"ev":"V","val":4512.34,"T":"I:SPX","t":1633024800000}]`))
+	if err != nil || len(events) != 1 || events[0].Ticker != "I:SPX" || events[0].Value != 4512.34 {
+		t.Fatalf("unexpected events %+v, %v", events, err)
+	}
+}
@@ -0,0 +1,224 @@
+package polygonio
+
+import (
+	"context"
+)
+
+const defaultPerPage = 50
+
+// maxPerPage is the largest page the reference tickers and news endpoints
+// serve, larger page sizes are silently capped.
+const maxPerPage = 50
+
+// fullPage returns the number of items of a full page of size perPage, 0 if
+// the endpoint does not serve pages that large.
+func fullPage(perPage int32) int {
+	if perPage > maxPerPage {
+		return 0
+	}
+	return int(perPage)
+}
+
+// pageFetcher fetches a single page (1 based) of a page numbered endpoint.
+type pageFetcher func(ctx context.Context, page int32) ([]interface{}, error)
+
+type pageResult struct {
+	items []interface{}
+	err   error
+}
+
+// pageIterator walks a page numbered endpoint until it returns a page shorter
+// than perPage. When perPage is 0, as the endpoint may serve fewer items than
+// requested, only an empty page ends the iteration, which costs one request
+// past the last page. Up to concurrency pages are fetched ahead of the
+// consumer, so as many as concurrency-1 more requests may be wasted past the
+// end; items are always yielded in page order.
+type pageIterator struct {
+	parent      context.Context
+	ctx         context.Context
+	cancel      context.CancelFunc
+	fetch       pageFetcher
+	perPage     int
+	concurrency int
+	nextPage    int32
+	pending     []chan pageResult
+	buf         []interface{}
+	cur         interface{}
+	done        bool
+	err         error
+}
+
+func newPageIterator(ctx context.Context, firstPage int32, perPage int, fetch pageFetcher) pageIterator {
+	if firstPage < 1 {
+		firstPage = 1
+	}
+	return pageIterator{
+		parent:      ctx,
+		fetch:       fetch,
+		perPage:     perPage,
+		concurrency: 1,
+		nextPage:    firstPage,
+	}
+}
+
+// Next advances to the next item, fetching pages as needed. It returns false
+// when all pages have been read or an error occurred, see Err.
+func (it *pageIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.parent.Err(); err != nil {
+		it.fail(err)
+		return false
+	}
+	for len(it.buf) == 0 {
+		if it.done {
+			return false
+		}
+		it.schedule()
+		res := <-it.pending[0]
+		it.pending = it.pending[1:]
+		if res.err != nil {
+			it.fail(res.err)
+			return false
+		}
+		if len(res.items) == 0 || len(res.items) < it.perPage {
+			// the last page, drop anything fetched ahead
+			it.done = true
+			it.Close()
+		}
+		it.buf = res.items
+	}
+	it.cur = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *pageIterator) Err() error {
+	return it.err
+}
+
+// Close stops any page fetches still in flight. It is only needed when the
+// iteration is abandoned before Next returns false.
+func (it *pageIterator) Close() {
+	if it.cancel != nil {
+		it.cancel()
+	}
+	it.pending = nil
+}
+
+func (it *pageIterator) fail(err error) {
+	it.err = err
+	it.Close()
+}
+
+func (it *pageIterator) schedule() {
+	if it.ctx == nil {
+		it.ctx, it.cancel = context.WithCancel(it.parent)
+	}
+	for len(it.pending) < it.concurrency {
+		c := make(chan pageResult, 1)
+		go func(page int32) {
+			items, err := it.fetch(it.ctx, page)
+			c <- pageResult{items: items, err: err}
+		}(it.nextPage)
+		it.pending = append(it.pending, c)
+		it.nextPage++
+	}
+}
+
+func (it *pageIterator) setConcurrency(n int) {
+	if n < 1 {
+		n = 1
+	}
+	it.concurrency = n
+}
+
+// TickerIterator iterates over all tickers matching a TickerOptions.
+type TickerIterator struct {
+	pageIterator
+}
+
+// WithConcurrency fetches up to n pages in parallel. It must be called before
+// the first call to Next. Pages fetched ahead past the last one are dropped,
+// each still costs a request.
+func (it *TickerIterator) WithConcurrency(n int) *TickerIterator {
+	it.setConcurrency(n)
+	return it
+}
+
+// Value returns the current ticker.
+func (it *TickerIterator) Value() Ticker {
+	return it.cur.(Ticker)
+}
+
+// ReferenceTickersIter returns an iterator over every page of
+// ReferenceTickers, starting at opts.Page. opts is not modified.
+func (c *Client) ReferenceTickersIter(ctx context.Context, opts *TickerOptions) *TickerIterator {
+	var base TickerOptions
+	if opts != nil {
+		base = *opts
+	}
+	if base.PerPage == 0 {
+		base.PerPage = defaultPerPage
+	}
+	fetch := func(ctx context.Context, page int32) ([]interface{}, error) {
+		pageOpts := base
+		pageOpts.Page = page
+		tickers, err := c.ReferenceTickersContext(ctx, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, len(tickers))
+		for i := range tickers {
+			items[i] = tickers[i]
+		}
+		return items, nil
+	}
+	return &TickerIterator{newPageIterator(ctx, base.Page, fullPage(base.PerPage), fetch)}
+}
+
+// NewsIterator iterates over all news items of a ticker.
+type NewsIterator struct {
+	pageIterator
+}
+
+// WithConcurrency fetches up to n pages in parallel. It must be called before
+// the first call to Next. Pages fetched ahead past the last one are dropped,
+// each still costs a request.
+func (it *NewsIterator) WithConcurrency(n int) *NewsIterator {
+	it.setConcurrency(n)
+	return it
+}
+
+// Value returns the current news item.
+func (it *NewsIterator) Value() TickerNews {
+	return it.cur.(TickerNews)
+}
+
+// ReferenceTickerNewsIter returns an iterator over every page of
+// ReferenceTickerNews, starting at opts.Page. opts is not modified.
+func (c *Client) ReferenceTickerNewsIter(ctx context.Context, ticker string, opts *NewsOptions) *NewsIterator {
+	var base NewsOptions
+	if opts != nil {
+		base = *opts
+	}
+	if base.PerPage == 0 {
+		base.PerPage = defaultPerPage
+	}
+	fetch := func(ctx context.Context, page int32) ([]interface{}, error) {
+		pageOpts := base
+		pageOpts.Page = page
+		news, err := c.ReferenceTickerNewsContext(ctx, ticker, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, len(news))
+		for i := range news {
+			items[i] = news[i]
+		}
+		return items, nil
+	}
+	return &NewsIterator{newPageIterator(ctx, base.Page, fullPage(base.PerPage), fetch)}
+}
@@ -0,0 +1,102 @@
+package polygonio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// tickerPages serves total tickers, at most maxPerPage per page if set.
+func tickerPages(total, maxPerPage int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("perpage"))
+		if maxPerPage > 0 && perPage > maxPerPage {
+			perPage = maxPerPage
+		}
+		out := struct {
+			Tickers Tickers `json:"tickers"`
+		}{Tickers: Tickers{}}
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			out.Tickers = append(out.Tickers, Ticker{Ticker: strconv.Itoa(i)})
+		}
+		json.NewEncoder(w).Encode(out)
+	})
+}
+
+func TestTickerIterator(t *testing.T) {
+	for _, concurrency := range []int{1, 3} {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			tickerPages(5, 0).ServeHTTP(w, r)
+		}))
+		client := NewClient("KEY", WithBaseURL(srv.URL))
+		opts := &TickerOptions{PerPage: 2}
+		it := client.ReferenceTickersIter(context.Background(), opts).WithConcurrency(concurrency)
+		n := 0
+		for it.Next() {
+			if it.Value().Ticker != strconv.Itoa(n) {
+				t.Fatalf("out of order ticker %s at %d", it.Value().Ticker, n)
+			}
+			n++
+		}
+		srv.Close()
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if n != 5 {
+			t.Fatalf("expected 5 tickers with concurrency %d, got %d", concurrency, n)
+		}
+		if opts.Page != 0 {
+			t.Fatal("iterator modified the caller's options")
+		}
+		// the short third page ends the walk without asking for a fourth
+		if calls := atomic.LoadInt32(&calls); concurrency == 1 && calls != 3 {
+			t.Fatalf("expected 3 page requests, got %d", calls)
+		}
+	}
+}
+
+func TestTickerIteratorCappedPageSize(t *testing.T) {
+	srv := httptest.NewServer(tickerPages(120, maxPerPage))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	// pages of 50 are not short, only the empty fourth page ends the walk
+	it := client.ReferenceTickersIter(context.Background(), &TickerOptions{PerPage: 100})
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if it.Err() != nil || n != 120 {
+		t.Fatalf("expected 120 tickers, got %d, %v", n, it.Err())
+	}
+}
+
+func TestTickerIteratorCanceled(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		tickerPages(100, 0).ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := client.ReferenceTickersIter(ctx, &TickerOptions{PerPage: 10})
+	for i := 0; i < 15 && it.Next(); i++ {
+	}
+	cancel()
+	if it.Next() || !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", it.Err())
+	}
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("expected 2 page requests, got %d", calls)
+	}
+}
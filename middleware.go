@@ -0,0 +1,128 @@
+package polygonio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Doer sends a single HTTP request. *http.Client satisfies it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer used for every request made by the Client, e.g.
+// for logging, metrics, header injection or fault injection. Use
+// RequestInfoFrom to find out which endpoint a request is for.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middlewares to the Client. The first middleware is the
+// outermost one and sees each request first. Retries and rate limiting
+// happen outside of the chain, so every attempt passes through it.
+func WithMiddleware(middlewares ...Middleware) func(*Client) {
+	return func(client *Client) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
+// RequestInfo describes the logical call behind an HTTP request.
+type RequestInfo struct {
+	Endpoint string        // logical endpoint name, e.g. "Aggregates" or "SnapshotAll"
+	Class    EndpointClass // cost class of the endpoint
+	URL      string        // request URL with the API key redacted
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoFrom returns the RequestInfo the Client attached to req.
+func RequestInfoFrom(req *http.Request) (RequestInfo, bool) {
+	info, ok := req.Context().Value(requestInfoKey{}).(RequestInfo)
+	return info, ok
+}
+
+func (c *Client) withRequestInfo(ctx context.Context, address string) context.Context {
+	r := lookupRoute(address)
+	return context.WithValue(ctx, requestInfoKey{}, RequestInfo{
+		Endpoint: r.name,
+		Class:    r.class,
+		URL:      c.redact(address),
+	})
+}
+
+func (c *Client) buildDoer() {
+	var doer Doer = c.httpClient
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	c.doer = doer
+}
+
+// CallStats summarizes a single HTTP call.
+type CallStats struct {
+	RequestInfo
+	StatusCode int           // zero if no response was received
+	Latency    time.Duration // from sending the request until the body was closed
+	Bytes      int64         // response body bytes as received, before decompression
+	Err        error
+}
+
+// Observe returns a Middleware that calls fn once per HTTP call, after the
+// response body has been closed or the request failed.
+func Observe(fn func(CallStats)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			info, _ := RequestInfoFrom(req)
+			stats := CallStats{RequestInfo: info}
+			start := time.Now()
+			resp, err := next.Do(req)
+			if err != nil {
+				stats.Latency = time.Since(start)
+				stats.Err = err
+				fn(stats)
+				return resp, err
+			}
+			stats.StatusCode = resp.StatusCode
+			resp.Body = &observedBody{ReadCloser: resp.Body, done: func(n int64, err error) {
+				stats.Latency = time.Since(start)
+				stats.Bytes = n
+				stats.Err = err
+				fn(stats)
+			}}
+			return resp, nil
+		})
+	}
+}
+
+type observedBody struct {
+	io.ReadCloser
+	n    int64
+	err  error
+	once sync.Once
+	done func(n int64, err error)
+}
+
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err != nil && err != io.EOF {
+		b.err = err
+	}
+	return n, err
+}
+
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.done(b.n, b.err)
+	})
+	return err
+}
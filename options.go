@@ -0,0 +1,167 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// OptionSymbol identifies a listed option contract the way OCC symbols do:
+// the root symbol of the underlying, the expiration date, put or call and the
+// strike price.
+type OptionSymbol struct {
+	Underlying string
+	Expiry     time.Time
+	Type       OptionType
+	Strike     float64
+}
+
+// ParseOptionSymbol parses an OCC symbol such as "AAPL  210917C00150000",
+// with or without the padding of the root symbol, or the Polygon ticker
+// "O:AAPL210917C00150000".
+func ParseOptionSymbol(s string) (OptionSymbol, error) {
+	code := strings.TrimPrefix(s, "O:")
+	invalid := fmt.Errorf("polygon: invalid option symbol %q", s)
+	if len(code) < 16 {
+		return OptionSymbol{}, invalid
+	}
+	root, tail := strings.TrimSpace(code[:len(code)-15]), code[len(code)-15:]
+	if root == "" || len(root) > 6 {
+		return OptionSymbol{}, invalid
+	}
+	expiry, err := time.Parse("060102", tail[:6])
+	if err != nil {
+		return OptionSymbol{}, invalid
+	}
+	var typ OptionType
+	switch tail[6] {
+	case 'C':
+		typ = Call
+	case 'P':
+		typ = Put
+	default:
+		return OptionSymbol{}, invalid
+	}
+	strike, err := strconv.ParseUint(tail[7:], 10, 64)
+	if err != nil {
+		return OptionSymbol{}, invalid
+	}
+	return OptionSymbol{Underlying: root, Expiry: expiry, Type: typ, Strike: float64(strike) / 1000}, nil
+}
+
+func (s OptionSymbol) code() string {
+	cp := 'C'
+	if s.Type == Put {
+		cp = 'P'
+	}
+	return fmt.Sprintf("%s%c%08d", s.Expiry.Format("060102"), cp, int64(math.Round(s.Strike*1000)))
+}
+
+// String returns the unpadded OCC symbol, e.g. AAPL210917C00150000.
+func (s OptionSymbol) String() string {
+	return s.Underlying + s.code()
+}
+
+// OCC returns the 21 character OCC symbol, with the root padded to six
+// characters.
+func (s OptionSymbol) OCC() string {
+	return fmt.Sprintf("%-6s%s", s.Underlying, s.code())
+}
+
+// Ticker returns the Polygon ticker of the contract, e.g.
+// O:AAPL210917C00150000.
+func (s OptionSymbol) Ticker() string {
+	return "O:" + s.String()
+}
+
+// OptionsContract returns the reference data of a contract.
+func (c *Client) OptionsContract(symbol OptionSymbol) (OptionContract, error) {
+	return c.OptionsContractContext(context.Background(), symbol)
+}
+
+func (c *Client) OptionsContractContext(ctx context.Context, symbol OptionSymbol) (OptionContract, error) {
+	out := struct {
+		Results OptionContract `json:"results"`
+	}{}
+	endpoint := fmt.Sprintf("/v3/reference/options/contracts/%s", url.PathEscape(symbol.Ticker()))
+	err := c.GetJSON(ctx, endpoint, &out)
+	return out.Results, err
+}
+
+func (c *Client) OptionsPreviousClose(symbol OptionSymbol, opts *RequestOptions) (*Bars, error) {
+	return c.OptionsPreviousCloseContext(context.Background(), symbol, opts)
+}
+
+func (c *Client) OptionsPreviousCloseContext(ctx context.Context, symbol OptionSymbol, opts *RequestOptions) (*Bars, error) {
+	return c.StockPreviousCloseContext(ctx, symbol.Ticker(), opts)
+}
+
+func (c *Client) OptionsAggregates(symbol OptionSymbol, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.OptionsAggregatesContext(context.Background(), symbol, multiplier, timespan, from, to, opts)
+}
+
+func (c *Client) OptionsAggregatesContext(ctx context.Context, symbol OptionSymbol, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockAggregatesContext(ctx, symbol.Ticker(), multiplier, timespan, from, to, opts)
+}
+
+// OptionsTradesIter returns an iterator over the trades of a contract,
+// following the pages of the v3 trades endpoint. opts is not modified.
+func (c *Client) OptionsTradesIter(ctx context.Context, symbol OptionSymbol, opts *V3TickOptions) *V3TradeIterator {
+	return c.v3TradesIter(ctx, symbol.Ticker(), opts)
+}
+
+// OptionsQuotesIter returns an iterator over the quotes of a contract, see
+// OptionsTradesIter.
+func (c *Client) OptionsQuotesIter(ctx context.Context, symbol OptionSymbol, opts *V3TickOptions) *V3QuoteIterator {
+	return c.v3QuotesIter(ctx, symbol.Ticker(), opts)
+}
+
+// OptionsChainSnapshot returns the snapshots of all contracts on underlying
+// matching opts, following every page of the chain.
+func (c *Client) OptionsChainSnapshot(underlying string, opts *OptionsChainOptions) (OptionSnapshots, error) {
+	return c.OptionsChainSnapshotContext(context.Background(), underlying, opts)
+}
+
+func (c *Client) OptionsChainSnapshotContext(ctx context.Context, underlying string, opts *OptionsChainOptions) (OptionSnapshots, error) {
+	endpoint := fmt.Sprintf("/v3/snapshot/options/%s", url.PathEscape(underlying))
+	endpoint, err := c.optionsChainWithOpts(endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := OptionSnapshots{}
+	pager := c.NewPager(endpoint)
+	for {
+		var page struct {
+			Results OptionSnapshots `json:"results"`
+		}
+		if !pager.Next(ctx, &page) {
+			break
+		}
+		out = append(out, page.Results...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) optionsChainWithOpts(endpoint string, opts *OptionsChainOptions) (string, error) {
+	if opts == nil {
+		return endpoint, nil
+	}
+	v, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	optParams := v.Encode()
+	if optParams != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, optParams)
+	}
+	return endpoint, nil
+}
@@ -0,0 +1,68 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseOptionSymbol(t *testing.T) {
+	want := OptionSymbol{Underlying: "AAPL", Expiry: date("2021-09-17"), Type: Call, Strike: 150}
+	for _, s := range []string{"AAPL210917C00150000", "AAPL  210917C00150000", "O:AAPL210917C00150000"} {
+		symbol, err := ParseOptionSymbol(s)
+		if err != nil || symbol != want {
+			t.Errorf("%s: unexpected symbol %+v, %v", s, symbol, err)
+		}
+	}
+	for _, s := range []string{"", "AAPL", "210917C00150000", "AAPL210917X00150000", "AAPL211317C00150000", "TOOLONG210917C00150000"} {
+		if _, err := ParseOptionSymbol(s); err == nil {
+			t.Errorf("%s: expected an error", s)
+		}
+	}
+
+	spy := OptionSymbol{Underlying: "SPY", Expiry: date("2022-01-21"), Type: Put, Strike: 412.5}
+	if s := spy.String(); s != "SPY220121P00412500" {
+		t.Errorf("unexpected symbol %s", s)
+	}
+	if s := spy.OCC(); s != "SPY   220121P00412500" {
+		t.Errorf("unexpected OCC symbol %q", s)
+	}
+	if s := spy.Ticker(); s != "O:SPY220121P00412500" {
+		t.Errorf("unexpected ticker %s", s)
+	}
+}
+
+func TestOptionsTradesIter(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Del("apiKey")
+		paths = append(paths, r.URL.Path+"?"+q.Encode())
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprintf(w, `{"results":[{"id":"1","price":4.1,"size":2,"sip_timestamp":10,"participant_timestamp":9,"sequence_number":4294967303,"tape":3,"trf_id":4,"correction":1}],"next_url":"http://%s/v3/trades/O:AAPL210917C00150000?cursor=abc&apiKey=KEY"}`, r.Host)
+			return
+		}
+		fmt.Fprint(w, `{"results":[{"id":"2","price":4.2,"size":0.25,"sip_timestamp":11}]}`)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	symbol, _ := ParseOptionSymbol("O:AAPL210917C00150000")
+	it := client.OptionsTradesIter(context.Background(), symbol, &V3TickOptions{Timestamp: "2021-09-01", Limit: 1})
+	var trades V3Trades
+	for it.Next() {
+		trades = append(trades, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := V3Trade{ID: "1", Price: 4.1, Size: 2, SIPTimestamp: 10, ParticipantTimestamp: 9, SequenceNumber: 1<<32 + 7, Tape: 3, TRFID: 4, Correction: 1}
+	if len(trades) != 2 || fmt.Sprint(trades[0]) != fmt.Sprint(want) || trades[1].ID != "2" || trades[1].Size != 0.25 {
+		t.Fatalf("unexpected trades %+v", trades)
+	}
+	if len(paths) != 2 || paths[0] != "/v3/trades/O:AAPL210917C00150000?limit=1&timestamp=2021-09-01" || paths[1] != "/v3/trades/O:AAPL210917C00150000?cursor=abc" {
+		t.Fatalf("unexpected requests %v", paths)
+	}
+}
@@ -0,0 +1,118 @@
+package polygonio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// DefaultMaxPages is the number of pages a Pager follows before giving up
+// with ErrTooManyPages.
+const DefaultMaxPages = 1000
+
+var ErrTooManyPages = errors.New("polygon: too many pages")
+
+// GetJSONPage fetches one page of a cursor paginated endpoint into v and
+// returns the endpoint of the next page, or "" on the last page. The next_url
+// returned by Polygon is rebased onto the Client's base URL and the API key is
+// applied again.
+func (c *Client) GetJSONPage(ctx context.Context, endpoint string, v interface{}) (string, error) {
+	data, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return "", err
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return "", err
+	}
+	cursor := struct {
+		NextURL string `json:"next_url"`
+	}{}
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return "", err
+	}
+	return nextEndpoint(cursor.NextURL)
+}
+
+// nextEndpoint turns an absolute next_url into an endpoint relative to the
+// base URL.
+func nextEndpoint(nextURL string) (string, error) {
+	if nextURL == "" {
+		return "", nil
+	}
+	u, err := url.Parse(nextURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Del("apiKey")
+	u.RawQuery = q.Encode()
+	return u.RequestURI(), nil
+}
+
+// Pager follows the next_url cursor of an endpoint page by page:
+//
+//	p := client.NewPager("/v3/trades/AAPL?limit=50000")
+//	var page struct{ Results []json.RawMessage `json:"results"` }
+//	for p.Next(ctx, &page) {
+//		...
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Pager struct {
+	// MaxPages caps the number of pages fetched, Next fails with
+	// ErrTooManyPages once it is exceeded.
+	MaxPages int
+
+	c     *Client
+	next  string
+	seen  map[string]bool
+	pages int
+	err   error
+}
+
+func (c *Client) NewPager(endpoint string) *Pager {
+	return &Pager{
+		MaxPages: DefaultMaxPages,
+		c:        c,
+		next:     endpoint,
+		seen:     make(map[string]bool),
+	}
+}
+
+// Next fetches the next page into v. It returns false when there are no more
+// pages or an error occurred, see Err.
+func (p *Pager) Next(ctx context.Context, v interface{}) bool {
+	if p.err != nil || p.next == "" {
+		return false
+	}
+	if p.MaxPages > 0 && p.pages >= p.MaxPages {
+		p.err = fmt.Errorf("%w: stopped after %d pages", ErrTooManyPages, p.pages)
+		return false
+	}
+	if p.seen[p.next] {
+		p.err = fmt.Errorf("polygon: cursor did not advance past %s", p.c.redact(p.next))
+		return false
+	}
+	p.seen[p.next] = true
+	next, err := p.c.GetJSONPage(ctx, p.next, v)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.pages++
+	p.next = next
+	return true
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Pages returns the number of pages fetched so far.
+func (p *Pager) Pages() int {
+	return p.pages
+}
@@ -0,0 +1,62 @@
+package polygonio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func cursorPages(t *testing.T, pages int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apiKey") != "KEY" {
+			t.Errorf("missing api key on %s", r.URL)
+		}
+		var cursor int
+		fmt.Sscanf(r.URL.Query().Get("cursor"), "%d", &cursor)
+		next := ""
+		if cursor+1 < pages {
+			// Polygon returns absolute URLs to its own host
+			next = fmt.Sprintf("https://api.polygon.io/v3/things?cursor=%d", cursor+1)
+		}
+		fmt.Fprintf(w, `{"results":[%d],"next_url":%q}`, cursor, next)
+	})
+}
+
+func TestPagerFollowsNextURL(t *testing.T) {
+	srv := httptest.NewServer(cursorPages(t, 3))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	p := client.NewPager("/v3/things")
+	var page struct {
+		Results []int `json:"results"`
+	}
+	var got []int
+	for p.Next(context.Background(), &page) {
+		got = append(got, page.Results...)
+	}
+	if p.Err() != nil {
+		t.Fatal(p.Err())
+	}
+	if fmt.Sprint(got) != "[0 1 2]" || p.Pages() != 3 {
+		t.Fatalf("unexpected results %v after %d pages", got, p.Pages())
+	}
+}
+
+func TestPagerMaxPages(t *testing.T) {
+	srv := httptest.NewServer(cursorPages(t, 10))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	p := client.NewPager("/v3/things")
+	p.MaxPages = 2
+	var page struct{}
+	for p.Next(context.Background(), &page) {
+	}
+	if !errors.Is(p.Err(), ErrTooManyPages) || p.Pages() != 2 {
+		t.Fatalf("expected ErrTooManyPages after 2 pages, got %v after %d", p.Err(), p.Pages())
+	}
+}
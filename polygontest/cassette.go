@@ -0,0 +1,210 @@
+package polygontest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Cassette replays or records.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette file and fails every
+	// request it has no recording for. It never touches the network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the network and writes them to the
+	// cassette file, replacing any earlier recording.
+	ModeRecord
+)
+
+// ModeFromEnv returns ModeRecord if the POLYGON_RECORD environment variable
+// is set and ModeReplay otherwise, so that fixtures can be re-recorded with
+// POLYGON_RECORD=1 go test ./...
+func ModeFromEnv() Mode {
+	if os.Getenv("POLYGON_RECORD") != "" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Interaction is a recorded request and its response. The API key is
+// scrubbed from both.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Cassette is an http.RoundTripper that records Client sessions into a file
+// and replays them, e.g.
+//
+//	cassette, err := polygontest.NewCassette("testdata/aapl.json", polygontest.ModeFromEnv())
+//	client := polygonio.NewClient(os.Getenv("POLYGON_API_KEY"), polygonio.WithTransport(cassette))
+//
+// Requests are matched by method and URL without the API key. A request made
+// several times is answered with its recordings in order.
+type Cassette struct {
+	// Transport sends requests while recording, http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu           sync.Mutex
+	path         string
+	mode         Mode
+	interactions []Interaction
+	used         []bool
+}
+
+// NewCassette opens the cassette at path. In ModeReplay the file must exist.
+func NewCassette(path string, mode Mode) (*Cassette, error) {
+	c := &Cassette{path: path, mode: mode}
+	if mode == ModeRecord {
+		return c, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("polygontest: cannot replay cassette: %v", err)
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("polygontest: invalid cassette %s: %v", path, err)
+	}
+	c.used = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// Unused returns the recorded interactions that were not replayed.
+func (c *Cassette) Unused() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out []Interaction
+	for i, used := range c.used {
+		if !used {
+			out = append(out, c.interactions[i])
+		}
+	}
+	return out
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := requestKey(req)
+	if c.mode == ModeRecord {
+		return c.record(req, key)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, in := range c.interactions {
+		if !c.used[i] && in.Method == req.Method && in.URL == key {
+			c.used[i] = true
+			return in.response(req), nil
+		}
+	}
+	return nil, fmt.Errorf("polygontest: no recording for %s %s in cassette %s, re-record it with POLYGON_RECORD=1", req.Method, key, c.path)
+}
+
+func (c *Cassette) record(req *http.Request, key string) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	in := Interaction{
+		Method:     req.Method,
+		URL:        key,
+		StatusCode: resp.StatusCode,
+		Header:     http.Header{},
+		Body:       scrub(string(body), apiKey(req)),
+	}
+	for _, h := range []string{"Content-Type", "Retry-After"} {
+		if v := resp.Header.Get(h); v != "" {
+			in.Header.Set(h, v)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, in)
+	c.used = append(c.used, true)
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(c.path, data, 0644); err != nil {
+		return nil, err
+	}
+	return in.response(req), nil
+}
+
+func (in Interaction) response(req *http.Request) *http.Response {
+	header := http.Header{}
+	for k, v := range in.Header {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(in.Body)),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}
+}
+
+// readBody returns the decompressed body, recordings are stored as plain
+// text so they can be read and edited.
+func readBody(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return body, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(zr)
+}
+
+func apiKey(req *http.Request) string {
+	if key := req.URL.Query().Get("apiKey"); key != "" {
+		return key
+	}
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+}
+
+func scrub(s, key string) string {
+	if key == "" {
+		return s
+	}
+	s = strings.Replace(s, key, "REDACTED", -1)
+	return strings.Replace(s, url.QueryEscape(key), "REDACTED", -1)
+}
+
+// requestKey is the URL of req without the API key and with its query
+// parameters sorted.
+func requestKey(req *http.Request) string {
+	u := *req.URL
+	q := u.Query()
+	q.Del("apiKey")
+	u.RawQuery = q.Encode()
+	u.User = nil
+	return u.String()
+}
@@ -0,0 +1,62 @@
+package polygontest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.json")
+
+	srv := NewServer(fixtures())
+	recorder, err := NewCassette(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := polygonio.NewClient("SECRET", polygonio.WithBaseURL(srv.URL), polygonio.WithTransport(recorder))
+	if _, err := client.ReferenceTickerDetail("AAPL"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ReferenceTickerDetail("NOPE"); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	srv.Close()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "SECRET") {
+		t.Fatal("cassette contains the API key")
+	}
+
+	player, err := NewCassette(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = polygonio.NewClient("OTHER", polygonio.WithBaseURL(srv.URL), polygonio.WithTransport(player))
+	details, err := client.ReferenceTickerDetail("AAPL")
+	if err != nil || details.Symbol != "AAPL" {
+		t.Fatalf("unexpected replay %+v, %v", details, err)
+	}
+	if _, err := client.ReferenceTickerDetail("NOPE"); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected replayed not found, got %v", err)
+	}
+	_, err = client.ReferenceTickerDetail("MSFT")
+	if err == nil || !strings.Contains(err.Error(), "no recording") {
+		t.Fatalf("expected an unmatched request error, got %v", err)
+	}
+	if unused := player.Unused(); len(unused) != 0 {
+		t.Fatalf("expected every recording to be used, got %+v", unused)
+	}
+}
@@ -0,0 +1,477 @@
+package polygontest
+
+import (
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+// The helpers below select fixture data the way Polygon would and are shared
+// by Server and FakeClient.
+
+const (
+	defaultLimit = 5000
+	maxLimit     = 50000
+	allTicks     = -1 // a tick limit selecting the whole window, as a walk over its pages does
+)
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+func (fx Fixtures) tickers(search, market string) polygonio.Tickers {
+	matched := polygonio.Tickers{}
+	for _, t := range fx.Tickers {
+		if market != "" && !strings.EqualFold(t.Market, market) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(t.Ticker+" "+t.Name), strings.ToLower(search)) {
+			continue
+		}
+		matched = append(matched, t)
+	}
+	return matched
+}
+
+// page returns the bounds of a 1 based page of n items.
+func page(n, page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	i := (page - 1) * perPage
+	if i > n {
+		i = n
+	}
+	j := i + perPage
+	if j > n {
+		j = n
+	}
+	return i, j
+}
+
+// bars returns the bars of ticker between lo and hi, Unix milliseconds, both
+// inclusive, in ascending order unless order is polygonio.Desc.
+func (fx Fixtures) bars(ticker string, lo, hi int64, order polygonio.Sort) polygonio.Bars {
+	out := polygonio.Bars{}
+	for _, b := range fx.Bars[ticker] {
+		if b.Time >= lo && b.Time <= hi {
+			out = append(out, b)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if order == polygonio.Desc {
+			return out[i].Time > out[j].Time
+		}
+		return out[i].Time < out[j].Time
+	})
+	return out
+}
+
+func (fx Fixtures) prevClose(ticker string) polygonio.Bars {
+	bars := fx.bars(ticker, 0, 1<<62, polygonio.Asc)
+	if len(bars) == 0 {
+		return polygonio.Bars{}
+	}
+	last := bars[len(bars)-1]
+	last.Ticker = ticker
+	return polygonio.Bars{last}
+}
+
+func dayMillis(day time.Time) (int64, int64) {
+	lo := day.UTC().Truncate(24 * time.Hour)
+	return lo.UnixNano() / int64(time.Millisecond), lo.AddDate(0, 0, 1).UnixNano()/int64(time.Millisecond) - 1
+}
+
+func dayNanos(day time.Time) (int64, int64) {
+	lo := day.UTC().Truncate(24 * time.Hour)
+	return lo.UnixNano(), lo.AddDate(0, 0, 1).UnixNano()
+}
+
+func (fx Fixtures) grouped(day time.Time) polygonio.Bars {
+	lo, hi := dayMillis(day)
+	out := polygonio.Bars{}
+	for ticker := range fx.Bars {
+		for _, b := range fx.bars(ticker, lo, hi, polygonio.Asc) {
+			b.Ticker = ticker
+			out = append(out, b)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Ticker < out[j].Ticker })
+	return out
+}
+
+func (fx Fixtures) daily(ticker string, day time.Time) (polygonio.Daily, bool) {
+	lo, hi := dayMillis(day)
+	bars := fx.bars(ticker, lo, hi, polygonio.Asc)
+	if len(bars) == 0 {
+		return polygonio.Daily{}, false
+	}
+	b := bars[0]
+	return polygonio.Daily{
+		Status: "OK", From: day.Format(polygonio.DateLayoutISO), Ticker: ticker, Volume: b.Volume,
+		Open: b.Open, Close: b.Close, High: b.High, Low: b.Low,
+	}, true
+}
+
+// tickWindow selects the indexes of the ticks of a day, as the legacy v2
+// ticks endpoints do: from the inclusive timestamp offset, before
+// timestampLimit, at most limit of them unless limit is allTicks.
+func tickWindow(n int, ts func(int) int64, day time.Time, offset, until int64, reverse bool, limit int) []int {
+	lo, hi := dayNanos(day)
+	if offset > lo {
+		lo = offset
+	}
+	if until > 0 && until < hi {
+		hi = until
+	}
+	idx := []int{}
+	for i := 0; i < n; i++ {
+		if t := ts(i); t >= lo && t < hi {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return ts(idx[i]) < ts(idx[j]) })
+	if reverse {
+		for i, j := 0, len(idx)-1; i < j; i, j = i+1, j-1 {
+			idx[i], idx[j] = idx[j], idx[i]
+		}
+	}
+	if limit == allTicks {
+		return idx
+	}
+	if limit = clampLimit(limit); len(idx) > limit {
+		idx = idx[:limit]
+	}
+	return idx
+}
+
+func (fx Fixtures) trades(ticker string, day time.Time, offset, until int64, reverse bool, limit int) polygonio.Trades {
+	all := fx.Trades[ticker]
+	idx := tickWindow(len(all), func(i int) int64 { return all[i].SIPTime }, day, offset, until, reverse, limit)
+	out := make(polygonio.Trades, len(idx))
+	for i, j := range idx {
+		out[i] = all[j]
+	}
+	return out
+}
+
+func (fx Fixtures) quotes(ticker string, day time.Time, offset, until int64, reverse bool, limit int) polygonio.Quotes {
+	all := fx.Quotes[ticker]
+	idx := tickWindow(len(all), func(i int) int64 { return all[i].SIPTime }, day, offset, until, reverse, limit)
+	out := make(polygonio.Quotes, len(idx))
+	for i, j := range idx {
+		out[i] = all[j]
+	}
+	return out
+}
+
+func (fx Fixtures) snapshots() polygonio.Snapshots {
+	return append(polygonio.Snapshots{}, fx.Snapshots...)
+}
+
+func (fx Fixtures) snapshot(ticker string) (polygonio.Snapshot, bool) {
+	for _, s := range fx.Snapshots {
+		if s.Ticker == ticker {
+			return s, true
+		}
+	}
+	return polygonio.Snapshot{}, false
+}
+
+// gainersLosers returns the top 20 snapshots by todays change.
+func (fx Fixtures) gainersLosers(direction polygonio.Direction) polygonio.Snapshots {
+	out := fx.snapshots()
+	sort.SliceStable(out, func(i, j int) bool {
+		if direction == polygonio.Gainers {
+			return out[i].TodayChangePct > out[j].TodayChangePct
+		}
+		return out[i].TodayChangePct < out[j].TodayChangePct
+	})
+	if len(out) > 20 {
+		out = out[:20]
+	}
+	return out
+}
+
+// historicWindow selects the indexes of the ticks of a day for the v1
+// historic endpoints: from the inclusive offset, at most limit of them, 100
+// by default and 10000 at most. Times are Unix milliseconds.
+func historicWindow(n int, ts func(int) int64, day time.Time, offset int64, limit int) []int {
+	lo, hi := dayMillis(day)
+	if offset > lo {
+		lo = offset
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 10000 {
+		limit = 10000
+	}
+	idx := []int{}
+	for i := 0; i < n; i++ {
+		if t := ts(i); t >= lo && t <= hi {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return ts(idx[i]) < ts(idx[j]) })
+	if len(idx) > limit {
+		idx = idx[:limit]
+	}
+	return idx
+}
+
+func (fx Fixtures) forexTicks(pair polygonio.CurrencyPair, day time.Time, offset int64, limit int) polygonio.ForexTicks {
+	all := fx.ForexTicks[pair.String()]
+	idx := historicWindow(len(all), func(i int) int64 { return all[i].Time }, day, offset, limit)
+	out := make(polygonio.ForexTicks, len(idx))
+	for i, j := range idx {
+		out[i] = all[j]
+	}
+	return out
+}
+
+func (fx Fixtures) cryptoTrades(pair polygonio.CurrencyPair, day time.Time, offset int64, limit int) polygonio.CryptoTrades {
+	all := fx.CryptoTrades[pair.String()]
+	idx := historicWindow(len(all), func(i int) int64 { return all[i].Time }, day, offset, limit)
+	out := make(polygonio.CryptoTrades, len(idx))
+	for i, j := range idx {
+		out[i] = all[j]
+	}
+	return out
+}
+
+// forexConversion converts amount at the ask of the last quote of pair.
+func (fx Fixtures) forexConversion(pair polygonio.CurrencyPair, amount float64, precision int) (polygonio.ForexConversion, bool) {
+	last, found := fx.ForexQuotes[pair.String()]
+	if !found {
+		return polygonio.ForexConversion{}, false
+	}
+	scale := math.Pow(10, float64(precision))
+	return polygonio.ForexConversion{
+		From: pair.From, To: pair.To, InitialAmount: amount,
+		Converted: math.Round(amount*last.Ask*scale) / scale, Last: last,
+	}, true
+}
+
+func (fx Fixtures) forexSnapshots() polygonio.ForexSnapshots {
+	return append(polygonio.ForexSnapshots{}, fx.ForexSnapshots...)
+}
+
+func (fx Fixtures) forexGainersLosers(direction polygonio.Direction) polygonio.ForexSnapshots {
+	out := fx.forexSnapshots()
+	sort.SliceStable(out, func(i, j int) bool {
+		if direction == polygonio.Gainers {
+			return out[i].TodayChangePct > out[j].TodayChangePct
+		}
+		return out[i].TodayChangePct < out[j].TodayChangePct
+	})
+	if len(out) > 20 {
+		out = out[:20]
+	}
+	return out
+}
+
+func (fx Fixtures) cryptoSnapshots() polygonio.CryptoSnapshots {
+	return append(polygonio.CryptoSnapshots{}, fx.CryptoSnapshots...)
+}
+
+// optionChain returns the snapshots of the contracts on underlying matching
+// the contract type, expiration date and strike price of opts.
+func (fx Fixtures) optionChain(underlying string, opts polygonio.OptionsChainOptions) polygonio.OptionSnapshots {
+	out := polygonio.OptionSnapshots{}
+	for _, s := range fx.OptionChains[underlying] {
+		d := s.Details
+		if opts.ContractType != "" && d.ContractType != opts.ContractType {
+			continue
+		}
+		if opts.ExpirationDate != "" && d.ExpirationDate != opts.ExpirationDate {
+			continue
+		}
+		if opts.StrikePrice != 0 && d.StrikePrice != opts.StrikePrice {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// indexBars converts the bars of an index, dropping the volume fields
+// indices do not have.
+func indexBars(bars polygonio.Bars) polygonio.IndexBars {
+	out := make(polygonio.IndexBars, len(bars))
+	for i, b := range bars {
+		out[i] = polygonio.IndexBar{
+			Ticker: b.Ticker, Time: b.Time,
+			Open: float64(b.Open), Close: float64(b.Close), High: float64(b.High), Low: float64(b.Low),
+		}
+	}
+	return out
+}
+
+// indexSnapshots returns the snapshots of tickers, in order, with an error
+// snapshot for the unknown ones, or all of them if tickers is empty.
+func (fx Fixtures) indexSnapshots(tickers []string) polygonio.IndexSnapshots {
+	if len(tickers) == 0 {
+		return append(polygonio.IndexSnapshots{}, fx.IndexSnapshots...)
+	}
+	out := polygonio.IndexSnapshots{}
+	for _, ticker := range tickers {
+		s := polygonio.IndexSnapshot{Ticker: ticker, Error: "NOT_FOUND", Message: "Ticker not found."}
+		for _, f := range fx.IndexSnapshots {
+			if f.Ticker == ticker {
+				s = f
+				break
+			}
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// cursorQuery returns the parameters of a cursor paginated request. A cursor
+// holds all the parameters of the page it points to and replaces the others.
+func cursorQuery(q url.Values) (url.Values, bool) {
+	cursor := q.Get("cursor")
+	if cursor == "" {
+		return q, true
+	}
+	q, err := url.ParseQuery(cursor)
+	return q, err == nil
+}
+
+// cursorPage returns the bounds of the page of n results at the offset of q
+// and the parameters of the following page, nil on the last one.
+func cursorPage(q url.Values, n, defLimit, maxLimit int) (int, int, url.Values) {
+	limit := atoi(q.Get("limit"), defLimit)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	i := atoi(q.Get("offset"), 0)
+	if i > n {
+		i = n
+	}
+	j := i + limit
+	if j >= n {
+		return i, n, nil
+	}
+	next := url.Values{}
+	for k, v := range q {
+		next[k] = v
+	}
+	next.Del("apiKey")
+	next.Set("offset", strconv.Itoa(j))
+	return i, j, next
+}
+
+// nanoBounds parses a v3 timestamp, a date or Unix nanoseconds, into the
+// nanoseconds it covers, from lo inclusive to hi exclusive.
+func nanoBounds(s string) (int64, int64, bool) {
+	if day, valid := parseDay(s); valid {
+		lo, hi := dayNanos(day)
+		return lo, hi, true
+	}
+	ns, err := strconv.ParseInt(s, 10, 64)
+	return ns, ns + 1, err == nil
+}
+
+// v3Window selects the indexes of the ticks matching the timestamp filters
+// of q, in ascending order unless order is desc, and cuts the page at the
+// offset of q: 1000 ticks by default, 50000 at most.
+func v3Window(n int, ts func(int) int64, q url.Values) ([]int, url.Values, bool) {
+	lo, hi := int64(math.MinInt64), int64(math.MaxInt64)
+	for _, f := range []struct {
+		param string
+		apply func(l, h int64)
+	}{
+		{"timestamp", func(l, h int64) { lo, hi = l, h }},
+		{"timestamp.gte", func(l, h int64) {
+			if l > lo {
+				lo = l
+			}
+		}},
+		{"timestamp.gt", func(l, h int64) {
+			if h > lo {
+				lo = h
+			}
+		}},
+		{"timestamp.lte", func(l, h int64) {
+			if h < hi {
+				hi = h
+			}
+		}},
+		{"timestamp.lt", func(l, h int64) {
+			if l < hi {
+				hi = l
+			}
+		}},
+	} {
+		if s := q.Get(f.param); s != "" {
+			l, h, valid := nanoBounds(s)
+			if !valid {
+				return nil, nil, false
+			}
+			f.apply(l, h)
+		}
+	}
+
+	idx := []int{}
+	for i := 0; i < n; i++ {
+		if t := ts(i); t >= lo && t < hi {
+			idx = append(idx, i)
+		}
+	}
+	desc := q.Get("order") == string(polygonio.Desc)
+	sort.SliceStable(idx, func(i, j int) bool {
+		if desc {
+			return ts(idx[i]) > ts(idx[j])
+		}
+		return ts(idx[i]) < ts(idx[j])
+	})
+	i, j, next := cursorPage(q, len(idx), 1000, maxLimit)
+	return idx[i:j], next, true
+}
+
+// v3Trades serves the trades of ticker in the v3 schema.
+func (fx Fixtures) v3Trades(ticker string, q url.Values) (polygonio.V3Trades, url.Values, bool) {
+	all := fx.Trades[ticker]
+	idx, next, valid := v3Window(len(all), func(i int) int64 { return all[i].SIPTime }, q)
+	out := make(polygonio.V3Trades, len(idx))
+	for i, j := range idx {
+		t := all[j]
+		out[i] = polygonio.V3Trade{
+			ID: t.TradeID, Exchange: t.Exchange, Price: t.Price, Size: float64(t.Size),
+			Conditions: t.Conditions, Correction: t.CorrID, SequenceNumber: int64(t.Sequence),
+			SIPTimestamp: t.SIPTime, ParticipantTimestamp: t.ExTime, TRFTimestamp: t.TRFTime,
+			TRFID: t.ReportID, Tape: t.ListedEx,
+		}
+	}
+	return out, next, valid
+}
+
+// v3Quotes serves the quotes of ticker in the v3 schema.
+func (fx Fixtures) v3Quotes(ticker string, q url.Values) (polygonio.V3Quotes, url.Values, bool) {
+	all := fx.Quotes[ticker]
+	idx, next, valid := v3Window(len(all), func(i int) int64 { return all[i].SIPTime }, q)
+	out := make(polygonio.V3Quotes, len(idx))
+	for i, j := range idx {
+		qt := all[j]
+		out[i] = polygonio.V3Quote{
+			BidExchange: qt.BidExchange, BidPrice: qt.BidPrice, BidSize: float64(qt.BidSize),
+			AskExchange: qt.AskExchange, AskPrice: qt.AskPrice, AskSize: float64(qt.AskSize),
+			Conditions: qt.Conditions, Indicators: qt.Indicators, SequenceNumber: int64(qt.Sequence),
+			SIPTimestamp: qt.SIPTime, ParticipantTimestamp: qt.ExTime, TRFTimestamp: qt.TRFTime, Tape: qt.ListedEx,
+		}
+	}
+	return out, next, valid
+}
@@ -0,0 +1,3 @@
+// Package polygontest provides in-process fakes of the Polygon APIs for
+// testing code that uses polygonio.
+package polygontest
@@ -0,0 +1,928 @@
+package polygontest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+var _ polygonio.API = (*FakeClient)(nil)
+
+// FakeClient implements polygonio.API in memory from Fixtures, without any
+// HTTP round trip. Set Fixtures before use. Methods answer the way Server
+// does, missing data yields a not found Error.
+//
+// Errors set with SetError are returned instead, calls are recorded as
+// "Method TICKER" (or just "Method") with the name of the method without
+// the Context suffix.
+type FakeClient struct {
+	Fixtures
+
+	mu     sync.Mutex
+	errors map[string]error
+	calls  []string
+}
+
+// SetError makes method fail with err, for all tickers or, with a key such
+// as "StockAggregates AAPL", for one ticker only. A nil err clears it.
+func (f *FakeClient) SetError(key string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.errors == nil {
+		f.errors = map[string]error{}
+	}
+	if err == nil {
+		delete(f.errors, key)
+		return
+	}
+	f.errors[key] = err
+}
+
+// Calls returns the calls made so far.
+func (f *FakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.calls...)
+}
+
+// call records a call and returns the error to fail it with, if any.
+func (f *FakeClient) call(ctx context.Context, method, ticker string) error {
+	key := method
+	if ticker != "" {
+		key += " " + ticker
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, key)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err, found := f.errors[key]; found {
+		return err
+	}
+	return f.errors[method]
+}
+
+func notFoundError(endpoint string) error {
+	return polygonio.Error{StatusCode: http.StatusNotFound, Status: "NOT_FOUND", Message: "not found", Endpoint: endpoint}
+}
+
+func badRequestError(endpoint, message string) error {
+	return polygonio.Error{StatusCode: http.StatusBadRequest, Status: "ERROR", Message: message, Endpoint: endpoint}
+}
+
+// v3Params returns the parameters a Server would receive for opts.
+func v3Params(opts *polygonio.V3TickOptions) (url.Values, error) {
+	if opts == nil {
+		return url.Values{}, nil
+	}
+	q, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+	q, valid := cursorQuery(q)
+	if !valid {
+		return nil, badRequestError("", "invalid cursor")
+	}
+	return q, nil
+}
+
+func encodeCursor(next url.Values) string {
+	if next == nil {
+		return ""
+	}
+	return next.Encode()
+}
+
+func requestOptions(opts *polygonio.RequestOptions) polygonio.RequestOptions {
+	if opts == nil {
+		return polygonio.RequestOptions{}
+	}
+	return *opts
+}
+
+func (f *FakeClient) ReferenceTickers(opts *polygonio.TickerOptions) (polygonio.Tickers, error) {
+	return f.ReferenceTickersContext(context.Background(), opts)
+}
+
+func (f *FakeClient) ReferenceTickersContext(ctx context.Context, opts *polygonio.TickerOptions) (polygonio.Tickers, error) {
+	if err := f.call(ctx, "ReferenceTickers", ""); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &polygonio.TickerOptions{}
+	}
+	perPage := int(opts.PerPage)
+	if perPage <= 0 {
+		perPage = 50
+	}
+	matched := f.tickers(opts.Search, string(opts.Market))
+	i, j := page(len(matched), int(opts.Page), perPage)
+	return append(polygonio.Tickers{}, matched[i:j]...), nil
+}
+
+func (f *FakeClient) ReferenceTickerTypes() (map[string]string, map[string]string, error) {
+	return f.ReferenceTickerTypesContext(context.Background())
+}
+
+func (f *FakeClient) ReferenceTickerTypesContext(ctx context.Context) (map[string]string, map[string]string, error) {
+	if err := f.call(ctx, "ReferenceTickerTypes", ""); err != nil {
+		return nil, nil, err
+	}
+	return map[string]string{}, map[string]string{}, nil
+}
+
+func (f *FakeClient) ReferenceTickerDetail(ticker string) (polygonio.TickerDetails, error) {
+	return f.ReferenceTickerDetailContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) ReferenceTickerDetailContext(ctx context.Context, ticker string) (polygonio.TickerDetails, error) {
+	if err := f.call(ctx, "ReferenceTickerDetail", ticker); err != nil {
+		return polygonio.TickerDetails{}, err
+	}
+	d, found := f.TickerDetails[ticker]
+	if !found {
+		return polygonio.TickerDetails{}, notFoundError(fmt.Sprintf("/v1/meta/symbols/%s/company", ticker))
+	}
+	return d, nil
+}
+
+func (f *FakeClient) ReferenceTickerNews(ticker string, opts *polygonio.NewsOptions) ([]polygonio.TickerNews, error) {
+	return f.ReferenceTickerNewsContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) ReferenceTickerNewsContext(ctx context.Context, ticker string, opts *polygonio.NewsOptions) ([]polygonio.TickerNews, error) {
+	if err := f.call(ctx, "ReferenceTickerNews", ticker); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &polygonio.NewsOptions{}
+	}
+	perPage := int(opts.PerPage)
+	if perPage <= 0 {
+		perPage = 50
+	}
+	news := f.TickerNews[ticker]
+	i, j := page(len(news), int(opts.Page), perPage)
+	return append([]polygonio.TickerNews{}, news[i:j]...), nil
+}
+
+func (f *FakeClient) ReferenceMarkets() (polygonio.MarketDescriptions, error) {
+	return f.ReferenceMarketsContext(context.Background())
+}
+
+func (f *FakeClient) ReferenceMarketsContext(ctx context.Context) (polygonio.MarketDescriptions, error) {
+	if err := f.call(ctx, "ReferenceMarkets", ""); err != nil {
+		return nil, err
+	}
+	return f.Markets, nil
+}
+
+func (f *FakeClient) ReferenceLocales() (polygonio.LocaleNames, error) {
+	return f.ReferenceLocalesContext(context.Background())
+}
+
+func (f *FakeClient) ReferenceLocalesContext(ctx context.Context) (polygonio.LocaleNames, error) {
+	if err := f.call(ctx, "ReferenceLocales", ""); err != nil {
+		return nil, err
+	}
+	return f.Locales, nil
+}
+
+func (f *FakeClient) ReferenceStockSplits(ticker string) (polygonio.Splits, error) {
+	return f.ReferenceStockSplitsContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) ReferenceStockSplitsContext(ctx context.Context, ticker string) (polygonio.Splits, error) {
+	if err := f.call(ctx, "ReferenceStockSplits", ticker); err != nil {
+		return nil, err
+	}
+	return f.Splits[ticker], nil
+}
+
+func (f *FakeClient) ReferenceDividends(ticker string) (polygonio.Dividends, error) {
+	return f.ReferenceDividendsContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) ReferenceDividendsContext(ctx context.Context, ticker string) (polygonio.Dividends, error) {
+	if err := f.call(ctx, "ReferenceDividends", ticker); err != nil {
+		return nil, err
+	}
+	return f.Dividends[ticker], nil
+}
+
+func (f *FakeClient) ReferenceFinancials(ticker string, opts *polygonio.FinancialOptions) (polygonio.Financials, error) {
+	return f.ReferenceFinancialsContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) ReferenceFinancialsContext(ctx context.Context, ticker string, opts *polygonio.FinancialOptions) (polygonio.Financials, error) {
+	if err := f.call(ctx, "ReferenceFinancials", ticker); err != nil {
+		return nil, err
+	}
+	financials := f.Financials[ticker]
+	if opts != nil && opts.Limit > 0 && int(opts.Limit) < len(financials) {
+		financials = financials[:opts.Limit]
+	}
+	return financials, nil
+}
+
+func (f *FakeClient) ReferenceMarketStatus() (polygonio.MarketStatus, error) {
+	return f.ReferenceMarketStatusContext(context.Background())
+}
+
+func (f *FakeClient) ReferenceMarketStatusContext(ctx context.Context) (polygonio.MarketStatus, error) {
+	if err := f.call(ctx, "ReferenceMarketStatus", ""); err != nil {
+		return polygonio.MarketStatus{}, err
+	}
+	return f.MarketStatus, nil
+}
+
+func (f *FakeClient) ReferenceMarketHolidays() (polygonio.MarketHolidays, error) {
+	return f.ReferenceMarketHolidaysContext(context.Background())
+}
+
+func (f *FakeClient) ReferenceMarketHolidaysContext(ctx context.Context) (polygonio.MarketHolidays, error) {
+	if err := f.call(ctx, "ReferenceMarketHolidays", ""); err != nil {
+		return nil, err
+	}
+	return f.MarketHolidays, nil
+}
+
+func (f *FakeClient) StockExchanges() (polygonio.Exchanges, error) {
+	return f.StockExchangesContext(context.Background())
+}
+
+func (f *FakeClient) StockExchangesContext(ctx context.Context) (polygonio.Exchanges, error) {
+	if err := f.call(ctx, "StockExchanges", ""); err != nil {
+		return nil, err
+	}
+	return f.Exchanges, nil
+}
+
+func (f *FakeClient) StockPreviousClose(ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.StockPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) StockPreviousCloseContext(ctx context.Context, ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.previousClose(ctx, "StockPreviousClose", ticker)
+}
+
+func (f *FakeClient) StockAggregates(ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.StockAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) StockAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.aggregates(ctx, "StockAggregates", ticker, from, to, opts)
+}
+
+func (f *FakeClient) StockAggregatesMillis(ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.StockAggregatesMillisContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) StockAggregatesMillisContext(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	if err := f.call(ctx, "StockAggregatesMillis", ticker); err != nil {
+		return nil, err
+	}
+	bars := f.bars(ticker, from.UnixNano()/int64(time.Millisecond), to.UnixNano()/int64(time.Millisecond), requestOptions(opts).Sort)
+	return &bars, nil
+}
+
+// StockAggregatesEach makes a single request, like Client it stops at
+// opts.Limit bars.
+func (f *FakeClient) StockAggregatesEach(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions, fn func(polygonio.Bar) error) error {
+	bars, err := f.aggregates(ctx, "StockAggregatesEach", ticker, from, to, opts)
+	if err != nil {
+		return err
+	}
+	if limit := clampLimit(int(requestOptions(opts).Limit)); len(*bars) > limit {
+		*bars = (*bars)[:limit]
+	}
+	for _, b := range *bars {
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StockAggregatesBatch fetches the tickers one after the other.
+func (f *FakeClient) StockAggregatesBatch(ctx context.Context, tickers []string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions, concurrency int) polygonio.AggregatesBatch {
+	out := polygonio.AggregatesBatch{Bars: map[string]*polygonio.Bars{}, Errors: map[string]error{}}
+	for _, ticker := range tickers {
+		bars, err := f.aggregates(ctx, "StockAggregatesBatch", ticker, from, to, opts)
+		if err != nil {
+			out.Errors[ticker] = err
+			continue
+		}
+		out.Bars[ticker] = bars
+	}
+	return out
+}
+
+func (f *FakeClient) StockGroupedDaily(locale polygonio.Locale, market polygonio.Market, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.StockGroupedDailyContext(context.Background(), locale, market, date, opts)
+}
+
+func (f *FakeClient) StockGroupedDailyContext(ctx context.Context, locale polygonio.Locale, market polygonio.Market, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.groupedDaily(ctx, "StockGroupedDaily", date)
+}
+
+func (f *FakeClient) StockTrades(ticker string, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Trades, error) {
+	return f.StockTradesContext(context.Background(), ticker, date, opts)
+}
+
+func (f *FakeClient) StockTradesContext(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Trades, error) {
+	if err := f.call(ctx, "StockTrades", ticker); err != nil {
+		return nil, err
+	}
+	o := requestOptions(opts)
+	trades := f.trades(ticker, date, o.Timestamp, o.TimestampLimit, o.Reverse == polygonio.ReserveTrue, int(o.Limit))
+	return &trades, nil
+}
+
+func (f *FakeClient) StockTradesEach(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions, fn func(polygonio.Trade) error) error {
+	if err := f.call(ctx, "StockTradesEach", ticker); err != nil {
+		return err
+	}
+	o := requestOptions(opts)
+	for _, t := range f.trades(ticker, date, o.Timestamp, o.TimestampLimit, o.Reverse == polygonio.ReserveTrue, int(o.Limit)) {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StockDailyTrades returns all trades of the day from opts.Timestamp and
+// before opts.TimestampLimit as a single page.
+func (f *FakeClient) StockDailyTrades(ticker string, date time.Time, opts *polygonio.RequestOptions) ([]*polygonio.Trades, error) {
+	return f.StockDailyTradesContext(context.Background(), ticker, date, opts)
+}
+
+func (f *FakeClient) StockDailyTradesContext(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions) ([]*polygonio.Trades, error) {
+	if err := f.call(ctx, "StockDailyTrades", ticker); err != nil {
+		return nil, err
+	}
+	o := requestOptions(opts)
+	trades := f.trades(ticker, date, o.Timestamp, o.TimestampLimit, false, allTicks)
+	return []*polygonio.Trades{&trades}, nil
+}
+
+func (f *FakeClient) StockQuotes(ticker string, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Quotes, error) {
+	return f.StockQuotesContext(context.Background(), ticker, date, opts)
+}
+
+func (f *FakeClient) StockQuotesContext(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Quotes, error) {
+	if err := f.call(ctx, "StockQuotes", ticker); err != nil {
+		return nil, err
+	}
+	o := requestOptions(opts)
+	quotes := f.quotes(ticker, date, o.Timestamp, o.TimestampLimit, o.Reverse == polygonio.ReserveTrue, int(o.Limit))
+	return &quotes, nil
+}
+
+func (f *FakeClient) StockQuotesEach(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions, fn func(polygonio.Quote) error) error {
+	if err := f.call(ctx, "StockQuotesEach", ticker); err != nil {
+		return err
+	}
+	o := requestOptions(opts)
+	for _, q := range f.quotes(ticker, date, o.Timestamp, o.TimestampLimit, o.Reverse == polygonio.ReserveTrue, int(o.Limit)) {
+		if err := fn(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StockDailyQuotes returns all quotes of the day from opts.Timestamp and
+// before opts.TimestampLimit as a single page.
+func (f *FakeClient) StockDailyQuotes(ticker string, date time.Time, opts *polygonio.RequestOptions) ([]*polygonio.Quotes, error) {
+	return f.StockDailyQuotesContext(context.Background(), ticker, date, opts)
+}
+
+func (f *FakeClient) StockDailyQuotesContext(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions) ([]*polygonio.Quotes, error) {
+	if err := f.call(ctx, "StockDailyQuotes", ticker); err != nil {
+		return nil, err
+	}
+	o := requestOptions(opts)
+	quotes := f.quotes(ticker, date, o.Timestamp, o.TimestampLimit, false, allTicks)
+	return []*polygonio.Quotes{&quotes}, nil
+}
+
+func (f *FakeClient) StockDailyTradesEach(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions, fn func(polygonio.Trade) error) error {
+	if err := f.call(ctx, "StockDailyTradesEach", ticker); err != nil {
+		return err
+	}
+	o := requestOptions(opts)
+	for _, t := range f.trades(ticker, date, o.Timestamp, o.TimestampLimit, false, allTicks) {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FakeClient) StockTradesV3(ticker string, opts *polygonio.V3TickOptions) (polygonio.V3Trades, string, error) {
+	return f.StockTradesV3Context(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) StockTradesV3Context(ctx context.Context, ticker string, opts *polygonio.V3TickOptions) (polygonio.V3Trades, string, error) {
+	if err := f.call(ctx, "StockTradesV3", ticker); err != nil {
+		return nil, "", err
+	}
+	q, err := v3Params(opts)
+	if err != nil {
+		return nil, "", err
+	}
+	trades, next, valid := f.v3Trades(ticker, q)
+	if !valid {
+		return nil, "", badRequestError("/v3/trades/"+ticker, "invalid timestamp")
+	}
+	return trades, encodeCursor(next), nil
+}
+
+func (f *FakeClient) StockQuotesV3(ticker string, opts *polygonio.V3TickOptions) (polygonio.V3Quotes, string, error) {
+	return f.StockQuotesV3Context(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) StockQuotesV3Context(ctx context.Context, ticker string, opts *polygonio.V3TickOptions) (polygonio.V3Quotes, string, error) {
+	if err := f.call(ctx, "StockQuotesV3", ticker); err != nil {
+		return nil, "", err
+	}
+	q, err := v3Params(opts)
+	if err != nil {
+		return nil, "", err
+	}
+	quotes, next, valid := f.v3Quotes(ticker, q)
+	if !valid {
+		return nil, "", badRequestError("/v3/quotes/"+ticker, "invalid timestamp")
+	}
+	return quotes, encodeCursor(next), nil
+}
+
+func (f *FakeClient) StockLastTrade(ticker string) (polygonio.LastTrade, error) {
+	return f.StockLastTradeContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) StockLastTradeContext(ctx context.Context, ticker string) (polygonio.LastTrade, error) {
+	if err := f.call(ctx, "StockLastTrade", ticker); err != nil {
+		return polygonio.LastTrade{}, err
+	}
+	last, found := f.LastTrades[ticker]
+	if !found {
+		return polygonio.LastTrade{}, notFoundError(fmt.Sprintf("/v1/last/stocks/%s", ticker))
+	}
+	return last, nil
+}
+
+func (f *FakeClient) StockDailyQuotesEach(ctx context.Context, ticker string, date time.Time, opts *polygonio.RequestOptions, fn func(polygonio.Quote) error) error {
+	if err := f.call(ctx, "StockDailyQuotesEach", ticker); err != nil {
+		return err
+	}
+	o := requestOptions(opts)
+	for _, q := range f.quotes(ticker, date, o.Timestamp, o.TimestampLimit, false, allTicks) {
+		if err := fn(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FakeClient) StockLastQuote(ticker string) (polygonio.LastQuote, error) {
+	return f.StockLastQuoteContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) StockLastQuoteContext(ctx context.Context, ticker string) (polygonio.LastQuote, error) {
+	if err := f.call(ctx, "StockLastQuote", ticker); err != nil {
+		return polygonio.LastQuote{}, err
+	}
+	last, found := f.LastQuotes[ticker]
+	if !found {
+		return polygonio.LastQuote{}, notFoundError(fmt.Sprintf("/v1/last_quote/stocks/%s", ticker))
+	}
+	return last, nil
+}
+
+func (f *FakeClient) StockDaily(ticker string, date time.Time) (*polygonio.Daily, error) {
+	return f.StockDailyContext(context.Background(), ticker, date)
+}
+
+func (f *FakeClient) StockDailyContext(ctx context.Context, ticker string, date time.Time) (*polygonio.Daily, error) {
+	if err := f.call(ctx, "StockDaily", ticker); err != nil {
+		return nil, err
+	}
+	d, found := f.daily(ticker, date)
+	if !found {
+		return nil, notFoundError(fmt.Sprintf("/v1/open-close/%s/%s", ticker, date.Format(polygonio.DateLayoutISO)))
+	}
+	return &d, nil
+}
+
+func (f *FakeClient) StockConditionMappings(tick polygonio.Tick) (map[string]string, error) {
+	return f.StockConditionMappingsContext(context.Background(), tick)
+}
+
+func (f *FakeClient) StockConditionMappingsContext(ctx context.Context, tick polygonio.Tick) (map[string]string, error) {
+	if err := f.call(ctx, "StockConditionMappings", ""); err != nil {
+		return nil, err
+	}
+	return f.Conditions[tick], nil
+}
+
+func (f *FakeClient) StockSnapshotAll() (*polygonio.Snapshots, error) {
+	return f.StockSnapshotAllContext(context.Background())
+}
+
+func (f *FakeClient) StockSnapshotAllContext(ctx context.Context) (*polygonio.Snapshots, error) {
+	if err := f.call(ctx, "StockSnapshotAll", ""); err != nil {
+		return nil, err
+	}
+	snapshots := f.snapshots()
+	return &snapshots, nil
+}
+
+func (f *FakeClient) StockSnapshotAllEach(ctx context.Context, fn func(polygonio.Snapshot) error) error {
+	if err := f.call(ctx, "StockSnapshotAllEach", ""); err != nil {
+		return err
+	}
+	for _, s := range f.snapshots() {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FakeClient) StockSnapshotSingle(ticker string) (*polygonio.Snapshot, error) {
+	return f.StockSnapshotSingleContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) StockSnapshotSingleContext(ctx context.Context, ticker string) (*polygonio.Snapshot, error) {
+	if err := f.call(ctx, "StockSnapshotSingle", ticker); err != nil {
+		return nil, err
+	}
+	s, found := f.snapshot(ticker)
+	if !found {
+		return nil, notFoundError(fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/tickers/%s", ticker))
+	}
+	return &s, nil
+}
+
+func (f *FakeClient) StockSnapshotTopGainersLosers(direction polygonio.Direction) (*polygonio.Snapshots, error) {
+	return f.StockSnapshotTopGainersLosersContext(context.Background(), direction)
+}
+
+func (f *FakeClient) StockSnapshotTopGainersLosersContext(ctx context.Context, direction polygonio.Direction) (*polygonio.Snapshots, error) {
+	if err := f.call(ctx, "StockSnapshotTopGainersLosers", ""); err != nil {
+		return nil, err
+	}
+	if direction != polygonio.Gainers && direction != polygonio.Losers {
+		return nil, notFoundError(fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/%s", direction))
+	}
+	snapshots := f.gainersLosers(direction)
+	return &snapshots, nil
+}
+
+func (f *FakeClient) CryptoExchanges() (polygonio.Exchanges, error) {
+	return f.CryptoExchangesContext(context.Background())
+}
+
+func (f *FakeClient) CryptoExchangesContext(ctx context.Context) (polygonio.Exchanges, error) {
+	if err := f.call(ctx, "CryptoExchanges", ""); err != nil {
+		return nil, err
+	}
+	return f.Exchanges, nil
+}
+
+func (f *FakeClient) CryptoPreviousClose(ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.CryptoPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) CryptoPreviousCloseContext(ctx context.Context, ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.previousClose(ctx, "CryptoPreviousClose", ticker)
+}
+
+func (f *FakeClient) CryptoAggregates(ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.CryptoAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) CryptoAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.aggregates(ctx, "CryptoAggregates", ticker, from, to, opts)
+}
+
+func (f *FakeClient) CryptoGroupedDaily(locale polygonio.Locale, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.CryptoGroupedDailyContext(context.Background(), locale, date, opts)
+}
+
+func (f *FakeClient) CryptoGroupedDailyContext(ctx context.Context, locale polygonio.Locale, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.groupedDaily(ctx, "CryptoGroupedDaily", date)
+}
+
+// CryptoDaily answers from the bars of the "X:FROMTO" ticker.
+func (f *FakeClient) CryptoDaily(from, to, date string) (polygonio.CryptoDaily, error) {
+	return f.CryptoDailyContext(context.Background(), from, to, date)
+}
+
+func (f *FakeClient) CryptoDailyContext(ctx context.Context, from, to, date string) (polygonio.CryptoDaily, error) {
+	ticker := "X:" + strings.ToUpper(from+to)
+	if err := f.call(ctx, "CryptoDaily", ticker); err != nil {
+		return polygonio.CryptoDaily{}, err
+	}
+	endpoint := fmt.Sprintf("/v1/open-close/crypto/%s/%s/%s", from, to, date)
+	day, valid := parseDay(date)
+	if !valid {
+		return polygonio.CryptoDaily{}, notFoundError(endpoint)
+	}
+	d, found := f.daily(ticker, day)
+	if !found {
+		return polygonio.CryptoDaily{}, notFoundError(endpoint)
+	}
+	return polygonio.CryptoDaily{
+		Ticker: strings.ToUpper(from + "-" + to), IsUTC: true, Day: date, Open: d.Open, Close: d.Close,
+		OpenTrades: polygonio.CryptoTrades{}, ClosingTrades: polygonio.CryptoTrades{},
+	}, nil
+}
+
+func (f *FakeClient) CryptoLastTradeForCryptoPair(pair polygonio.CurrencyPair) (polygonio.CryptoLastTrade, error) {
+	return f.CryptoLastTradeForCryptoPairContext(context.Background(), pair)
+}
+
+func (f *FakeClient) CryptoLastTradeForCryptoPairContext(ctx context.Context, pair polygonio.CurrencyPair) (polygonio.CryptoLastTrade, error) {
+	if err := f.call(ctx, "CryptoLastTradeForCryptoPair", pair.String()); err != nil {
+		return polygonio.CryptoLastTrade{}, err
+	}
+	last, found := f.CryptoLastTrades[pair.String()]
+	if !found {
+		return polygonio.CryptoLastTrade{}, notFoundError(fmt.Sprintf("/v1/last/crypto/%s/%s", pair.From, pair.To))
+	}
+	return last, nil
+}
+
+func (f *FakeClient) CryptoHistoricTrades(pair polygonio.CurrencyPair, date time.Time, opts *polygonio.CryptoTradesOptions) (*polygonio.CryptoTrades, error) {
+	return f.CryptoHistoricTradesContext(context.Background(), pair, date, opts)
+}
+
+func (f *FakeClient) CryptoHistoricTradesContext(ctx context.Context, pair polygonio.CurrencyPair, date time.Time, opts *polygonio.CryptoTradesOptions) (*polygonio.CryptoTrades, error) {
+	if err := f.call(ctx, "CryptoHistoricTrades", pair.String()); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &polygonio.CryptoTradesOptions{}
+	}
+	trades := f.cryptoTrades(pair, date, opts.Offset, int(opts.Limit))
+	return &trades, nil
+}
+
+func (f *FakeClient) CryptoSnapshotAll() (*polygonio.CryptoSnapshots, error) {
+	return f.CryptoSnapshotAllContext(context.Background())
+}
+
+func (f *FakeClient) CryptoSnapshotAllContext(ctx context.Context) (*polygonio.CryptoSnapshots, error) {
+	if err := f.call(ctx, "CryptoSnapshotAll", ""); err != nil {
+		return nil, err
+	}
+	snapshots := f.cryptoSnapshots()
+	return &snapshots, nil
+}
+
+func (f *FakeClient) CryptoSnapshotFullBook(ticker string) (polygonio.CryptoBook, error) {
+	return f.CryptoSnapshotFullBookContext(context.Background(), ticker)
+}
+
+func (f *FakeClient) CryptoSnapshotFullBookContext(ctx context.Context, ticker string) (polygonio.CryptoBook, error) {
+	if err := f.call(ctx, "CryptoSnapshotFullBook", ticker); err != nil {
+		return polygonio.CryptoBook{}, err
+	}
+	book, found := f.CryptoBooks[ticker]
+	if !found {
+		return polygonio.CryptoBook{}, notFoundError(fmt.Sprintf("/v2/snapshot/locale/global/markets/crypto/tickers/%s/book", ticker))
+	}
+	return book, nil
+}
+
+func (f *FakeClient) ForexPreviousClose(ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.ForexPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) ForexPreviousCloseContext(ctx context.Context, ticker string, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.previousClose(ctx, "ForexPreviousClose", ticker)
+}
+
+func (f *FakeClient) ForexAggregates(ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.ForexAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) ForexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.aggregates(ctx, "ForexAggregates", ticker, from, to, opts)
+}
+
+func (f *FakeClient) ForexGroupedDaily(locale polygonio.Locale, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.ForexGroupedDailyContext(context.Background(), locale, date, opts)
+}
+
+func (f *FakeClient) ForexGroupedDailyContext(ctx context.Context, locale polygonio.Locale, date time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.groupedDaily(ctx, "ForexGroupedDaily", date)
+}
+
+func (f *FakeClient) ForexHistoricTicks(pair polygonio.CurrencyPair, date time.Time, opts *polygonio.ForexTicksOptions) (*polygonio.ForexTicks, error) {
+	return f.ForexHistoricTicksContext(context.Background(), pair, date, opts)
+}
+
+func (f *FakeClient) ForexHistoricTicksContext(ctx context.Context, pair polygonio.CurrencyPair, date time.Time, opts *polygonio.ForexTicksOptions) (*polygonio.ForexTicks, error) {
+	if err := f.call(ctx, "ForexHistoricTicks", pair.String()); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &polygonio.ForexTicksOptions{}
+	}
+	ticks := f.forexTicks(pair, date, opts.Offset, int(opts.Limit))
+	return &ticks, nil
+}
+
+// ForexRealTimeConversion converts at the ask of ForexQuotes, 100 units
+// with a precision of 2 by default.
+func (f *FakeClient) ForexRealTimeConversion(pair polygonio.CurrencyPair, opts *polygonio.ConversionOptions) (polygonio.ForexConversion, error) {
+	return f.ForexRealTimeConversionContext(context.Background(), pair, opts)
+}
+
+func (f *FakeClient) ForexRealTimeConversionContext(ctx context.Context, pair polygonio.CurrencyPair, opts *polygonio.ConversionOptions) (polygonio.ForexConversion, error) {
+	if err := f.call(ctx, "ForexRealTimeConversion", pair.String()); err != nil {
+		return polygonio.ForexConversion{}, err
+	}
+	amount, precision := 100.0, 2
+	if opts != nil && opts.Amount != 0 {
+		amount = opts.Amount
+	}
+	if opts != nil && opts.Precision != 0 {
+		precision = int(opts.Precision)
+	}
+	conv, found := f.forexConversion(pair, amount, precision)
+	if !found {
+		return polygonio.ForexConversion{}, notFoundError(fmt.Sprintf("/v1/conversion/%s/%s", pair.From, pair.To))
+	}
+	return conv, nil
+}
+
+func (f *FakeClient) ForexLastQuotesForCurrencyPair(pair polygonio.CurrencyPair) (polygonio.ForexQuote, error) {
+	return f.ForexLastQuotesForCurrencyPairContext(context.Background(), pair)
+}
+
+func (f *FakeClient) ForexLastQuotesForCurrencyPairContext(ctx context.Context, pair polygonio.CurrencyPair) (polygonio.ForexQuote, error) {
+	if err := f.call(ctx, "ForexLastQuotesForCurrencyPair", pair.String()); err != nil {
+		return polygonio.ForexQuote{}, err
+	}
+	last, found := f.ForexQuotes[pair.String()]
+	if !found {
+		return polygonio.ForexQuote{}, notFoundError(fmt.Sprintf("/v1/last_quote/currencies/%s/%s", pair.From, pair.To))
+	}
+	return last, nil
+}
+
+func (f *FakeClient) ForexSnapshotAll() (*polygonio.ForexSnapshots, error) {
+	return f.ForexSnapshotAllContext(context.Background())
+}
+
+func (f *FakeClient) ForexSnapshotAllContext(ctx context.Context) (*polygonio.ForexSnapshots, error) {
+	if err := f.call(ctx, "ForexSnapshotAll", ""); err != nil {
+		return nil, err
+	}
+	snapshots := f.forexSnapshots()
+	return &snapshots, nil
+}
+
+func (f *FakeClient) ForexSnapshotTopGainersLosers(direction polygonio.Direction) (*polygonio.ForexSnapshots, error) {
+	return f.ForexSnapshotTopGainersLosersContext(context.Background(), direction)
+}
+
+func (f *FakeClient) ForexSnapshotTopGainersLosersContext(ctx context.Context, direction polygonio.Direction) (*polygonio.ForexSnapshots, error) {
+	if err := f.call(ctx, "ForexSnapshotTopGainersLosers", ""); err != nil {
+		return nil, err
+	}
+	if direction != polygonio.Gainers && direction != polygonio.Losers {
+		return nil, notFoundError(fmt.Sprintf("/v2/snapshot/locale/global/markets/forex/%s", direction))
+	}
+	snapshots := f.forexGainersLosers(direction)
+	return &snapshots, nil
+}
+
+func (f *FakeClient) OptionsContract(symbol polygonio.OptionSymbol) (polygonio.OptionContract, error) {
+	return f.OptionsContractContext(context.Background(), symbol)
+}
+
+func (f *FakeClient) OptionsContractContext(ctx context.Context, symbol polygonio.OptionSymbol) (polygonio.OptionContract, error) {
+	if err := f.call(ctx, "OptionsContract", symbol.Ticker()); err != nil {
+		return polygonio.OptionContract{}, err
+	}
+	contract, found := f.OptionContracts[symbol.Ticker()]
+	if !found {
+		return polygonio.OptionContract{}, notFoundError("/v3/reference/options/contracts/" + symbol.Ticker())
+	}
+	return contract, nil
+}
+
+func (f *FakeClient) OptionsPreviousClose(symbol polygonio.OptionSymbol, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.OptionsPreviousCloseContext(context.Background(), symbol, opts)
+}
+
+func (f *FakeClient) OptionsPreviousCloseContext(ctx context.Context, symbol polygonio.OptionSymbol, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.previousClose(ctx, "OptionsPreviousClose", symbol.Ticker())
+}
+
+func (f *FakeClient) OptionsAggregates(symbol polygonio.OptionSymbol, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.OptionsAggregatesContext(context.Background(), symbol, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) OptionsAggregatesContext(ctx context.Context, symbol polygonio.OptionSymbol, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	return f.aggregates(ctx, "OptionsAggregates", symbol.Ticker(), from, to, opts)
+}
+
+func (f *FakeClient) OptionsChainSnapshot(underlying string, opts *polygonio.OptionsChainOptions) (polygonio.OptionSnapshots, error) {
+	return f.OptionsChainSnapshotContext(context.Background(), underlying, opts)
+}
+
+func (f *FakeClient) OptionsChainSnapshotContext(ctx context.Context, underlying string, opts *polygonio.OptionsChainOptions) (polygonio.OptionSnapshots, error) {
+	if err := f.call(ctx, "OptionsChainSnapshot", underlying); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &polygonio.OptionsChainOptions{}
+	}
+	return f.optionChain(underlying, *opts), nil
+}
+
+func (f *FakeClient) IndexPreviousClose(ticker string, opts *polygonio.RequestOptions) (*polygonio.IndexBars, error) {
+	return f.IndexPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (f *FakeClient) IndexPreviousCloseContext(ctx context.Context, ticker string, opts *polygonio.RequestOptions) (*polygonio.IndexBars, error) {
+	bars, err := f.previousClose(ctx, "IndexPreviousClose", polygonio.IndexTicker(ticker))
+	if err != nil {
+		return nil, err
+	}
+	out := indexBars(*bars)
+	return &out, nil
+}
+
+func (f *FakeClient) IndexAggregates(ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.IndexBars, error) {
+	return f.IndexAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (f *FakeClient) IndexAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan polygonio.Timespan, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.IndexBars, error) {
+	bars, err := f.aggregates(ctx, "IndexAggregates", polygonio.IndexTicker(ticker), from, to, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := indexBars(*bars)
+	return &out, nil
+}
+
+func (f *FakeClient) IndexSnapshot(tickers ...string) (polygonio.IndexSnapshots, error) {
+	return f.IndexSnapshotContext(context.Background(), tickers...)
+}
+
+func (f *FakeClient) IndexSnapshotContext(ctx context.Context, tickers ...string) (polygonio.IndexSnapshots, error) {
+	if err := f.call(ctx, "IndexSnapshot", ""); err != nil {
+		return nil, err
+	}
+	symbols := make([]string, len(tickers))
+	for i, t := range tickers {
+		symbols[i] = polygonio.IndexTicker(t)
+	}
+	return f.indexSnapshots(symbols), nil
+}
+
+func (f *FakeClient) previousClose(ctx context.Context, method, ticker string) (*polygonio.Bars, error) {
+	if err := f.call(ctx, method, ticker); err != nil {
+		return nil, err
+	}
+	bars := f.prevClose(ticker)
+	return &bars, nil
+}
+
+// aggregates selects the bars from the start of the day of from to the end
+// of the day of to, as the date based endpoints do. Like Client, it returns
+// the whole range regardless of the limit.
+func (f *FakeClient) aggregates(ctx context.Context, method, ticker string, from, to time.Time, opts *polygonio.RequestOptions) (*polygonio.Bars, error) {
+	if err := f.call(ctx, method, ticker); err != nil {
+		return nil, err
+	}
+	lo, _ := dayMillis(from)
+	_, hi := dayMillis(to)
+	bars := f.bars(ticker, lo, hi, requestOptions(opts).Sort)
+	return &bars, nil
+}
+
+func (f *FakeClient) groupedDaily(ctx context.Context, method string, date time.Time) (*polygonio.Bars, error) {
+	if err := f.call(ctx, method, ""); err != nil {
+		return nil, err
+	}
+	bars := f.grouped(date)
+	return &bars, nil
+}
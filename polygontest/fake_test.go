@@ -0,0 +1,108 @@
+package polygontest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+func TestFakeClient(t *testing.T) {
+	var api polygonio.API = &FakeClient{Fixtures: fixtures()}
+
+	details, err := api.ReferenceTickerDetail("AAPL")
+	if err != nil || details.Name != "Apple Inc." {
+		t.Fatalf("unexpected details %+v, %v", details, err)
+	}
+	if _, err := api.ReferenceTickerDetail("NOPE"); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	bars, err := api.StockAggregates("AAPL", 1, polygonio.Day, day("2021-01-05"), day("2021-01-06"), nil)
+	if err != nil || len(*bars) != 2 || (*bars)[0].Close != 131 {
+		t.Fatalf("unexpected bars %+v, %v", bars, err)
+	}
+
+	trades, err := api.StockTrades("AAPL", day("2021-01-04"), &polygonio.RequestOptions{Limit: 4})
+	if err != nil || len(*trades) != 4 || (*trades)[0].Price != 100 {
+		t.Fatalf("unexpected trades %+v, %v", trades, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := api.ReferenceMarketStatusContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFakeClientErrors(t *testing.T) {
+	fake := &FakeClient{Fixtures: fixtures()}
+	boom := errors.New("boom")
+	fake.SetError("StockAggregates AAPL", boom)
+	fake.SetError("StockLastTrade", polygonio.Error{StatusCode: 429})
+
+	if _, err := fake.StockAggregates("AAPL", 1, polygonio.Day, day("2021-01-04"), day("2021-01-06"), nil); err != boom {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if _, err := fake.StockAggregates("MSFT", 1, polygonio.Day, day("2021-01-04"), day("2021-01-06"), nil); err != nil {
+		t.Fatalf("expected no error for another ticker, got %v", err)
+	}
+	if _, err := fake.StockLastTrade("MSFT"); !polygonio.IsRateLimited(err) {
+		t.Fatalf("expected rate limited, got %v", err)
+	}
+
+	// errors match the values returned by Client
+	var e polygonio.Error
+	if _, err := fake.StockLastQuote("NOPE"); !errors.As(err, &e) || e.StatusCode != http.StatusNotFound || !polygonio.IsNotFound(err) {
+		t.Fatalf("expected a not found Error value, got %#v", err)
+	}
+
+	fake.SetError("StockAggregates AAPL", nil)
+	if _, err := fake.StockAggregates("AAPL", 1, polygonio.Day, day("2021-01-04"), day("2021-01-06"), nil); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"StockAggregates AAPL", "StockAggregates MSFT", "StockLastTrade MSFT", "StockLastQuote NOPE", "StockAggregates AAPL"}
+	if calls := fake.Calls(); len(calls) != len(want) || calls[3] != want[3] {
+		t.Fatalf("unexpected calls %v", calls)
+	}
+}
+
+func TestFakeClientMatchesServer(t *testing.T) {
+	srv := NewServer(fixtures())
+	defer srv.Close()
+	base := day("2021-01-04")
+	opts := &polygonio.RequestOptions{
+		Timestamp:      base.Add(2 * time.Minute).UnixNano(),
+		TimestampLimit: base.Add(7 * time.Minute).UnixNano(),
+		Limit:          2,
+	}
+
+	for _, client := range []polygonio.StocksAPI{srv.PolygonClient(), &FakeClient{Fixtures: fixtures()}} {
+		pages, err := client.StockDailyTrades("AAPL", base, opts)
+		if err != nil {
+			t.Fatalf("%T: %v", client, err)
+		}
+		var prices []float64
+		for _, page := range pages {
+			for _, tr := range *page {
+				prices = append(prices, tr.Price)
+			}
+		}
+		if fmt.Sprint(prices) != "[102 103 104 105 106]" {
+			t.Fatalf("%T: unexpected prices %v", client, prices)
+		}
+
+		n := 0
+		err = client.StockAggregatesEach(context.Background(), "AAPL", 1, polygonio.Day, base, base.AddDate(0, 0, 2), &polygonio.RequestOptions{Limit: 2}, func(polygonio.Bar) error {
+			n++
+			return nil
+		})
+		if err != nil || n != 2 {
+			t.Fatalf("%T: expected 2 bars, got %d, %v", client, n, err)
+		}
+	}
+}
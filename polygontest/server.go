@@ -0,0 +1,548 @@
+package polygontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+// Fixtures is the data served by a Server. Bars are keyed by ticker with
+// Time in Unix milliseconds, trades and quotes by ticker with SIPTime in Unix
+// nanoseconds. Forex and crypto ticks and quotes are keyed by pair, e.g.
+// "EUR/USD", with times in Unix milliseconds, crypto books by ticker. Option
+// contracts are keyed by ticker, e.g. "O:AAPL210917C00150000", and option
+// chains by underlying. Index bars are Bars keyed by I: ticker, their volume
+// is ignored. Days are UTC days. Bars are served as stored, the multiplier
+// and timespan of a request are not applied.
+type Fixtures struct {
+	Tickers        polygonio.Tickers
+	TickerDetails  map[string]polygonio.TickerDetails
+	TickerNews     map[string][]polygonio.TickerNews
+	Markets        polygonio.MarketDescriptions
+	Locales        polygonio.LocaleNames
+	Splits         map[string]polygonio.Splits
+	Dividends      map[string]polygonio.Dividends
+	Financials     map[string]polygonio.Financials
+	Exchanges      polygonio.Exchanges
+	Conditions     map[polygonio.Tick]map[string]string
+	MarketStatus   polygonio.MarketStatus
+	MarketHolidays polygonio.MarketHolidays
+
+	Bars       map[string]polygonio.Bars
+	Trades     map[string]polygonio.Trades
+	Quotes     map[string]polygonio.Quotes
+	LastTrades map[string]polygonio.LastTrade
+	LastQuotes map[string]polygonio.LastQuote
+	Snapshots  polygonio.Snapshots
+
+	CryptoTrades     map[string]polygonio.CryptoTrades
+	CryptoLastTrades map[string]polygonio.CryptoLastTrade
+	CryptoSnapshots  polygonio.CryptoSnapshots
+	CryptoBooks      map[string]polygonio.CryptoBook
+
+	ForexTicks     map[string]polygonio.ForexTicks
+	ForexQuotes    map[string]polygonio.ForexQuote
+	ForexSnapshots polygonio.ForexSnapshots
+
+	OptionContracts map[string]polygonio.OptionContract
+	OptionChains    map[string]polygonio.OptionSnapshots
+
+	IndexSnapshots polygonio.IndexSnapshots
+}
+
+// Fault changes how the Server answers requests.
+type Fault struct {
+	Path       string        // only requests whose path starts with Path, all if empty
+	Times      int           // number of requests affected, 0 for every request
+	Status     int           // respond with this status code and an error body
+	RetryAfter string        // Retry-After header sent along with Status
+	Latency    time.Duration // delay before responding
+	Malformed  bool          // respond with a truncated JSON body
+}
+
+// Server is a fake of the Polygon REST API backed by Fixtures. Any non empty
+// API key is accepted, either as apiKey parameter or bearer token.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures Fixtures
+	faults   []*Fault
+	requests int
+}
+
+func NewServer(fixtures Fixtures) *Server {
+	s := &Server{fixtures: fixtures}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// PolygonClient returns a polygonio.Client talking to the Server. The
+// embedded httptest.Server's Client still returns its *http.Client.
+func (s *Server) PolygonClient(options ...func(*polygonio.Client)) *polygonio.Client {
+	return polygonio.NewClient("test-key", append([]func(*polygonio.Client){polygonio.WithBaseURL(s.URL)}, options...)...)
+}
+
+// SetFixtures replaces the served data.
+func (s *Server) SetFixtures(fixtures Fixtures) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = fixtures
+}
+
+// Inject adds a fault. Faults are matched in the order they were added.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// Reset removes all faults.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the number of requests served so far.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) fault(path string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	for i, f := range s.faults {
+		if !strings.HasPrefix(path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		out := *f
+		return &out
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f := s.fault(r.URL.Path)
+	if f != nil && f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if r.URL.Query().Get("apiKey") == "" && !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "missing API key")
+		return
+	}
+	if f != nil && f.Status != 0 {
+		if f.RetryAfter != "" {
+			w.Header().Set("Retry-After", f.RetryAfter)
+		}
+		writeError(w, f.Status, "ERROR", http.StatusText(f.Status))
+		return
+	}
+
+	s.mu.Lock()
+	fixtures := s.fixtures
+	s.mu.Unlock()
+	status, v := route(fixtures, r)
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "ERROR", err.Error())
+		return
+	}
+	if f != nil && f.Malformed {
+		body = body[:len(body)/2]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+type errorBody struct {
+	Status    string `json:"status"`
+	RequestID string `json:"request_id"`
+	Message   string `json:"message"`
+}
+
+var requestID int64
+
+func newErrorBody(status, message string) errorBody {
+	id := atomic.AddInt64(&requestID, 1)
+	return errorBody{Status: status, RequestID: fmt.Sprintf("fake-%d", id), Message: message}
+}
+
+func writeError(w http.ResponseWriter, code int, status, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(newErrorBody(status, message))
+}
+
+func notFound(message string) (int, interface{}) {
+	return http.StatusNotFound, newErrorBody("NOT_FOUND", message)
+}
+
+type results struct {
+	Status  string      `json:"status"`
+	Results interface{} `json:"results"`
+}
+
+func ok(v interface{}) (int, interface{}) {
+	return http.StatusOK, results{Status: "OK", Results: v}
+}
+
+// route answers a request from the fixtures.
+func route(fx Fixtures, r *http.Request) (int, interface{}) {
+	seg := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	q := r.URL.Query()
+	match := func(pattern string) bool {
+		p := strings.Split(pattern, "/")
+		if len(p) != len(seg) {
+			return false
+		}
+		for i := range p {
+			if p[i] != "*" && p[i] != seg[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case match("v2/reference/tickers"):
+		return tickers(fx, q.Get("search"), q.Get("market"), atoi(q.Get("page"), 1), atoi(q.Get("perpage"), 50))
+	case match("v2/reference/types"):
+		return ok(map[string]map[string]string{"types": {}, "indexTypes": {}})
+	case match("v1/meta/symbols/*/company"):
+		d, found := fx.TickerDetails[seg[3]]
+		if !found {
+			return notFound("ticker not found")
+		}
+		return http.StatusOK, d
+	case match("v1/meta/symbols/*/news"):
+		news := fx.TickerNews[seg[3]]
+		i, j := page(len(news), atoi(q.Get("page"), 1), atoi(q.Get("perpage"), 50))
+		return http.StatusOK, news[i:j]
+	case match("v2/reference/markets"):
+		return ok(fx.Markets)
+	case match("v2/reference/locales"):
+		return ok(fx.Locales)
+	case match("v2/reference/splits/*"):
+		return ok(fx.Splits[seg[3]])
+	case match("v2/reference/dividends/*"):
+		return ok(fx.Dividends[seg[3]])
+	case match("v2/reference/financials/*"):
+		return ok(fx.Financials[seg[3]])
+	case match("v1/meta/exchanges"), match("v1/meta/crypto-exchanges"):
+		return http.StatusOK, fx.Exchanges
+	case match("v1/meta/conditions/*"):
+		return http.StatusOK, fx.Conditions[polygonio.Tick(seg[3])]
+	case match("v1/marketstatus/now"):
+		return http.StatusOK, fx.MarketStatus
+	case match("v1/marketstatus/upcoming"):
+		return http.StatusOK, fx.MarketHolidays
+
+	case match("v2/aggs/ticker/*/prev"):
+		return ok(fx.prevClose(seg[3]))
+	case match("v2/aggs/ticker/*/range/*/*/*/*"):
+		return aggregates(fx, seg[3], seg[7], seg[8], polygonio.Sort(q.Get("sort")), atoi(q.Get("limit"), 0))
+	case match("v2/aggs/grouped/locale/*/market/*/*"):
+		return grouped(fx, seg[7])
+	case match("v1/open-close/*/*"):
+		return daily(fx, seg[2], seg[3])
+
+	case match("v2/ticks/stocks/trades/*/*"):
+		return ticks(seg[5], q, func(day time.Time, offset, until int64, reverse bool, limit int) (interface{}, int) {
+			ticks := fx.trades(seg[4], day, offset, until, reverse, limit)
+			return ticks, len(ticks)
+		})
+	case match("v2/ticks/stocks/nbbo/*/*"):
+		return ticks(seg[5], q, func(day time.Time, offset, until int64, reverse bool, limit int) (interface{}, int) {
+			ticks := fx.quotes(seg[4], day, offset, until, reverse, limit)
+			return ticks, len(ticks)
+		})
+	case match("v1/last/stocks/*"):
+		last, found := fx.LastTrades[seg[3]]
+		if !found {
+			return notFound("ticker not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "success", "symbol": seg[3], "last": last}
+	case match("v1/last_quote/stocks/*"):
+		last, found := fx.LastQuotes[seg[3]]
+		if !found {
+			return notFound("ticker not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "success", "symbol": seg[3], "last": last}
+
+	case match("v2/snapshot/locale/us/markets/stocks/tickers"):
+		return http.StatusOK, map[string]interface{}{"status": "OK", "tickers": fx.snapshots()}
+	case match("v2/snapshot/locale/us/markets/stocks/tickers/*"):
+		s, found := fx.snapshot(seg[7])
+		if !found {
+			return notFound("ticker not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "ticker": s}
+	case match("v2/snapshot/locale/us/markets/stocks/*"):
+		direction := polygonio.Direction(seg[6])
+		if direction != polygonio.Gainers && direction != polygonio.Losers {
+			return notFound("unknown direction")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "tickers": fx.gainersLosers(direction)}
+
+	case match("v1/last/crypto/*/*"):
+		pair := polygonio.CurrencyPair{From: seg[3], To: seg[4]}
+		last, found := fx.CryptoLastTrades[pair.String()]
+		if !found {
+			return notFound("pair not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "success", "symbol": pair.From + "-" + pair.To, "last": last}
+	case match("v1/historic/crypto/*/*/*"):
+		pair := polygonio.CurrencyPair{From: seg[3], To: seg[4]}
+		day, valid := parseDay(seg[5])
+		if !valid {
+			return http.StatusBadRequest, newErrorBody("ERROR", "invalid date")
+		}
+		offset, _ := strconv.ParseInt(q.Get("offset"), 10, 64)
+		return http.StatusOK, map[string]interface{}{
+			"status": "success", "day": seg[5], "symbol": pair.From + "-" + pair.To,
+			"ticks": fx.cryptoTrades(pair, day, offset, atoi(q.Get("limit"), 0)),
+		}
+	case match("v2/snapshot/locale/global/markets/crypto/tickers"):
+		return http.StatusOK, map[string]interface{}{"status": "OK", "tickers": fx.cryptoSnapshots()}
+	case match("v2/snapshot/locale/global/markets/crypto/tickers/*/book"):
+		book, found := fx.CryptoBooks[seg[7]]
+		if !found {
+			return notFound("ticker not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "data": book}
+
+	case match("v1/historic/forex/*/*/*"):
+		pair := polygonio.CurrencyPair{From: seg[3], To: seg[4]}
+		day, valid := parseDay(seg[5])
+		if !valid {
+			return http.StatusBadRequest, newErrorBody("ERROR", "invalid date")
+		}
+		offset, _ := strconv.ParseInt(q.Get("offset"), 10, 64)
+		return http.StatusOK, map[string]interface{}{
+			"status": "success", "day": seg[5], "pair": pair.String(),
+			"ticks": fx.forexTicks(pair, day, offset, atoi(q.Get("limit"), 0)),
+		}
+	case match("v1/conversion/*/*"):
+		amount, err := strconv.ParseFloat(q.Get("amount"), 64)
+		if err != nil {
+			amount = 100
+		}
+		conv, found := fx.forexConversion(polygonio.CurrencyPair{From: seg[2], To: seg[3]}, amount, atoi(q.Get("precision"), 2))
+		if !found {
+			return notFound("pair not found")
+		}
+		return http.StatusOK, conv
+	case match("v1/last_quote/currencies/*/*"):
+		pair := polygonio.CurrencyPair{From: seg[3], To: seg[4]}
+		last, found := fx.ForexQuotes[pair.String()]
+		if !found {
+			return notFound("pair not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "success", "symbol": pair.String(), "last": last}
+	case match("v2/snapshot/locale/global/markets/forex/tickers"):
+		return http.StatusOK, map[string]interface{}{"status": "OK", "tickers": fx.forexSnapshots()}
+	case match("v2/snapshot/locale/global/markets/forex/*"):
+		direction := polygonio.Direction(seg[6])
+		if direction != polygonio.Gainers && direction != polygonio.Losers {
+			return notFound("unknown direction")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "tickers": fx.forexGainersLosers(direction)}
+
+	case match("v3/snapshot/indices"):
+		var tickers []string
+		if list := q.Get("ticker.any_of"); list != "" {
+			tickers = strings.Split(list, ",")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "results": fx.indexSnapshots(tickers)}
+
+	case match("v3/reference/options/contracts/*"):
+		contract, found := fx.OptionContracts[seg[4]]
+		if !found {
+			return notFound("contract not found")
+		}
+		return http.StatusOK, map[string]interface{}{"status": "OK", "results": contract}
+	case match("v3/snapshot/options/*"):
+		return optionChain(fx, r, seg[3])
+	case match("v3/trades/*"):
+		return v3Ticks(r, func(q url.Values) (interface{}, url.Values, bool) {
+			return fx.v3Trades(seg[2], q)
+		})
+	case match("v3/quotes/*"):
+		return v3Ticks(r, func(q url.Values) (interface{}, url.Values, bool) {
+			return fx.v3Quotes(seg[2], q)
+		})
+	}
+	return notFound(fmt.Sprintf("no fake for %s", r.URL.Path))
+}
+
+func atoi(s string, def int) int {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return def
+	}
+	return n
+}
+
+func tickers(fx Fixtures, search, market string, pg, perPage int) (int, interface{}) {
+	matched := fx.tickers(search, market)
+	i, j := page(len(matched), pg, perPage)
+	return http.StatusOK, map[string]interface{}{
+		"status":  "OK",
+		"page":    pg,
+		"perPage": perPage,
+		"count":   len(matched),
+		"tickers": matched[i:j],
+	}
+}
+
+// parseBound parses an aggregates bound, a date or Unix milliseconds. end
+// selects the end of the day for dates.
+func parseBound(s string, end bool) (int64, bool) {
+	if d, err := time.Parse(polygonio.DateLayoutISO, s); err == nil {
+		if end {
+			d = d.AddDate(0, 0, 1).Add(-time.Millisecond)
+		}
+		return d.UnixNano() / int64(time.Millisecond), true
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	return ms, err == nil
+}
+
+func aggregates(fx Fixtures, ticker, from, to string, order polygonio.Sort, limit int) (int, interface{}) {
+	lo, ok1 := parseBound(from, false)
+	hi, ok2 := parseBound(to, true)
+	if !ok1 || !ok2 {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid from or to")
+	}
+	all := fx.bars(ticker, lo, hi, order)
+	queryCount := len(all)
+	if limit = clampLimit(limit); len(all) > limit {
+		all = all[:limit]
+	}
+	return http.StatusOK, map[string]interface{}{
+		"ticker":       ticker,
+		"status":       "OK",
+		"adjusted":     true,
+		"queryCount":   queryCount,
+		"resultsCount": len(all),
+		"results":      all,
+	}
+}
+
+func parseDay(date string) (time.Time, bool) {
+	d, err := time.Parse(polygonio.DateLayoutISO, date)
+	return d, err == nil
+}
+
+func grouped(fx Fixtures, date string) (int, interface{}) {
+	day, valid := parseDay(date)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid date")
+	}
+	return ok(fx.grouped(day))
+}
+
+func daily(fx Fixtures, ticker, date string) (int, interface{}) {
+	day, valid := parseDay(date)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid date")
+	}
+	d, found := fx.daily(ticker, day)
+	if !found {
+		return notFound("no data for date")
+	}
+	return http.StatusOK, d
+}
+
+// ticks serves the legacy v2 ticks endpoints from the parameters of q.
+func ticks(date string, q url.Values, pick func(day time.Time, offset, until int64, reverse bool, limit int) (interface{}, int)) (int, interface{}) {
+	day, valid := parseDay(date)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid date")
+	}
+	offset, _ := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+	until, _ := strconv.ParseInt(q.Get("timestampLimit"), 10, 64)
+	reverse := q.Get("reverse") == string(polygonio.ReserveTrue)
+	results, n := pick(day, offset, until, reverse, atoi(q.Get("limit"), 0))
+	return http.StatusOK, map[string]interface{}{
+		"status":        "OK",
+		"results_count": n,
+		"results":       results,
+	}
+}
+
+// v3Query returns the parameters of a v3 request, see cursorQuery.
+func v3Query(r *http.Request) (url.Values, bool) {
+	return cursorQuery(r.URL.Query())
+}
+
+// nextURL returns the next_url of the page with the parameters next, empty
+// if there is none.
+func nextURL(r *http.Request, next url.Values) string {
+	if next == nil {
+		return ""
+	}
+	return "http://" + r.Host + r.URL.Path + "?" + url.Values{"cursor": {next.Encode()}}.Encode()
+}
+
+// v3Ticks serves a page of the v3 trades or quotes endpoints.
+func v3Ticks(r *http.Request, pick func(q url.Values) (interface{}, url.Values, bool)) (int, interface{}) {
+	q, valid := v3Query(r)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid cursor")
+	}
+	results, next, valid := pick(q)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid timestamp")
+	}
+	body := map[string]interface{}{"status": "OK", "results": results}
+	if next != nil {
+		body["next_url"] = nextURL(r, next)
+	}
+	return http.StatusOK, body
+}
+
+// optionChain serves the snapshots of an options chain, 10 per page by
+// default and 250 at most.
+func optionChain(fx Fixtures, r *http.Request, underlying string) (int, interface{}) {
+	q, valid := v3Query(r)
+	if !valid {
+		return http.StatusBadRequest, newErrorBody("ERROR", "invalid cursor")
+	}
+	strike, _ := strconv.ParseFloat(q.Get("strike_price"), 64)
+	chain := fx.optionChain(underlying, polygonio.OptionsChainOptions{
+		ContractType:   polygonio.OptionType(q.Get("contract_type")),
+		ExpirationDate: q.Get("expiration_date"),
+		StrikePrice:    strike,
+	})
+	i, j, next := cursorPage(q, len(chain), 10, 250)
+	body := map[string]interface{}{"status": "OK", "results": chain[i:j]}
+	if next != nil {
+		body["next_url"] = nextURL(r, next)
+	}
+	return http.StatusOK, body
+}
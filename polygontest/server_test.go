@@ -0,0 +1,371 @@
+package polygontest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+func day(s string) time.Time {
+	d, err := time.Parse(polygonio.DateLayoutISO, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func fixtures() Fixtures {
+	base := day("2021-01-04")
+	var trades polygonio.Trades
+	for i := 0; i < 10; i++ {
+		trades = append(trades, polygonio.Trade{SIPTime: base.Add(time.Duration(i) * time.Minute).UnixNano(), Price: float64(100 + i)})
+	}
+	return Fixtures{
+		TickerDetails: map[string]polygonio.TickerDetails{"AAPL": {Symbol: "AAPL", Name: "Apple Inc."}},
+		Bars: map[string]polygonio.Bars{"AAPL": {
+			{Time: base.UnixNano() / 1e6, Close: 129},
+			{Time: base.AddDate(0, 0, 1).UnixNano() / 1e6, Close: 131},
+			{Time: base.AddDate(0, 0, 2).UnixNano() / 1e6, Close: 126},
+		}},
+		Trades:       map[string]polygonio.Trades{"AAPL": trades},
+		MarketStatus: polygonio.MarketStatus{Market: "open"},
+	}
+}
+
+func TestServerEndpoints(t *testing.T) {
+	srv := NewServer(fixtures())
+	defer srv.Close()
+	client := srv.PolygonClient()
+
+	details, err := client.ReferenceTickerDetail("AAPL")
+	if err != nil || details.Name != "Apple Inc." {
+		t.Fatalf("unexpected details %+v, %v", details, err)
+	}
+	if _, err := client.ReferenceTickerDetail("NOPE"); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	bars, err := client.StockAggregates("AAPL", 1, polygonio.Day, day("2021-01-05"), day("2021-01-06"), nil)
+	if err != nil || len(*bars) != 2 || (*bars)[0].Close != 131 {
+		t.Fatalf("unexpected bars %+v, %v", bars, err)
+	}
+
+	pages, err := client.StockDailyTrades("AAPL", day("2021-01-04"), &polygonio.RequestOptions{Limit: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) < 3 {
+		t.Fatalf("expected several pages, got %d", len(pages))
+	}
+
+	status, err := client.ReferenceMarketStatus()
+	if err != nil || status.Market != "open" {
+		t.Fatalf("unexpected market status %+v, %v", status, err)
+	}
+}
+
+func TestServerTicksPastMaxLimit(t *testing.T) {
+	base := day("2021-01-04")
+	trades := make(polygonio.Trades, maxLimit+3)
+	quotes := make(polygonio.Quotes, len(trades))
+	for i := range trades {
+		trades[i] = polygonio.Trade{SIPTime: base.Add(time.Duration(i) * time.Millisecond).UnixNano()}
+		quotes[i] = polygonio.Quote{SIPTime: trades[i].SIPTime}
+	}
+	srv := NewServer(Fixtures{
+		Trades: map[string]polygonio.Trades{"AAPL": trades},
+		Quotes: map[string]polygonio.Quotes{"AAPL": quotes},
+	})
+	defer srv.Close()
+	client := srv.PolygonClient()
+
+	// a limit past the server maximum must not end the walk at the first page
+	opts := &polygonio.RequestOptions{Limit: 2 * maxLimit}
+	pages, err := client.StockDailyTrades("AAPL", base, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, page := range pages {
+		n += len(*page)
+	}
+	if n != len(trades) {
+		t.Fatalf("expected %d trades, got %d", len(trades), n)
+	}
+	quotePages, err := client.StockDailyQuotes("AAPL", base, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n = 0
+	for _, page := range quotePages {
+		n += len(*page)
+	}
+	if n != len(quotes) {
+		t.Fatalf("expected %d quotes, got %d", len(quotes), n)
+	}
+	it := client.StockTradesIter(context.Background(), "AAPL", base, opts)
+	for n = 0; it.Next(); n++ {
+	}
+	if err := it.Err(); err != nil || n != len(trades) {
+		t.Fatalf("expected %d trades, got %d, %v", len(trades), n, err)
+	}
+	if opts.Limit != 2*maxLimit {
+		t.Fatalf("opts modified, limit %d", opts.Limit)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	srv := NewServer(fixtures())
+	defer srv.Close()
+
+	srv.Inject(Fault{Path: "/v1/marketstatus", Times: 2, Status: 429, RetryAfter: "0"})
+	client := srv.PolygonClient(polygonio.WithRetry(polygonio.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if _, err := client.ReferenceMarketStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Requests() != 3 {
+		t.Fatalf("expected 3 requests, got %d", srv.Requests())
+	}
+
+	srv.Inject(Fault{Times: 1, Malformed: true})
+	if _, err := client.ReferenceMarketStatus(); err == nil {
+		t.Fatal("expected an error for a malformed body")
+	}
+
+	srv.Inject(Fault{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.ReferenceMarketStatusContext(ctx); err == nil {
+		t.Fatal("expected a timeout")
+	}
+	srv.Reset()
+	if _, err := client.ReferenceMarketStatus(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServerForex(t *testing.T) {
+	eurusd := polygonio.CurrencyPair{From: "EUR", To: "USD"}
+	base := day("2021-01-04").UnixNano() / 1e6
+	srv := NewServer(Fixtures{
+		ForexTicks:  map[string]polygonio.ForexTicks{"EUR/USD": {{Ask: 1.2, Time: base}, {Ask: 1.3, Time: base + 1}, {Ask: 1.4, Time: base + 2}}},
+		ForexQuotes: map[string]polygonio.ForexQuote{"EUR/USD": {Ask: 1.25, Bid: 1.24}},
+		ForexSnapshots: polygonio.ForexSnapshots{
+			{Ticker: "C:EURUSD", TodayChangePct: 0.5},
+			{Ticker: "C:USDJPY", TodayChangePct: -0.2},
+		},
+	})
+	defer srv.Close()
+	client := srv.PolygonClient()
+
+	ticks, err := client.ForexHistoricTicks(eurusd, day("2021-01-04"), &polygonio.ForexTicksOptions{Offset: base + 1, Limit: 1})
+	if err != nil || len(*ticks) != 1 || (*ticks)[0].Ask != 1.3 {
+		t.Fatalf("unexpected ticks %+v, %v", ticks, err)
+	}
+
+	// the offset is inclusive, the second page repeats the last tick of the first
+	first, err := client.ForexHistoricTicks(eurusd, day("2021-01-04"), &polygonio.ForexTicksOptions{Limit: 2})
+	if err != nil || len(*first) != 2 {
+		t.Fatalf("unexpected first page %+v, %v", first, err)
+	}
+	last := (*first)[1]
+	second, err := client.ForexHistoricTicks(eurusd, day("2021-01-04"), &polygonio.ForexTicksOptions{Offset: last.Time, Limit: 2})
+	if err != nil || len(*second) != 2 || (*second)[0] != last || (*second)[1].Ask != 1.4 {
+		t.Fatalf("unexpected second page %+v, %v", second, err)
+	}
+	conv, err := client.ForexRealTimeConversion(eurusd, &polygonio.ConversionOptions{Amount: 10})
+	if err != nil || conv.Converted != 12.5 {
+		t.Fatalf("unexpected conversion %+v, %v", conv, err)
+	}
+	quote, err := client.ForexLastQuotesForCurrencyPair(eurusd)
+	if err != nil || quote.Bid != 1.24 {
+		t.Fatalf("unexpected last quote %+v, %v", quote, err)
+	}
+	losers, err := client.ForexSnapshotTopGainersLosers(polygonio.Losers)
+	if err != nil || len(*losers) != 2 || (*losers)[0].Ticker != "C:USDJPY" {
+		t.Fatalf("unexpected losers %+v, %v", losers, err)
+	}
+}
+
+func TestServerCrypto(t *testing.T) {
+	btcusd := polygonio.CurrencyPair{From: "BTC", To: "USD"}
+	base := day("2021-01-04").UnixNano() / 1e6
+	srv := NewServer(Fixtures{
+		CryptoTrades:     map[string]polygonio.CryptoTrades{"BTC/USD": {{Price: 31000, Time: base}, {Price: 31010, Time: base + 1}}},
+		CryptoLastTrades: map[string]polygonio.CryptoLastTrade{"BTC/USD": {Price: 31020, Exchange: 1}},
+		CryptoBooks: map[string]polygonio.CryptoBook{"X:BTCUSD": {
+			Ticker: "X:BTCUSD",
+			Bids:   []polygonio.BookLevel{{Price: 31000, Sizes: map[string]float64{"1": 0.5, "2": 1.5}}},
+			Asks:   []polygonio.BookLevel{{Price: 31005, Sizes: map[string]float64{"1": 1}}},
+			Spread: 5,
+		}},
+	})
+	defer srv.Close()
+	client := srv.PolygonClient()
+
+	trades, err := client.CryptoHistoricTrades(btcusd, day("2021-01-04"), &polygonio.CryptoTradesOptions{Offset: base + 1})
+	if err != nil || len(*trades) != 1 || (*trades)[0].Price != 31010 {
+		t.Fatalf("unexpected trades %+v, %v", trades, err)
+	}
+
+	// the offset is inclusive, the second page repeats the last trade of the first
+	first, err := client.CryptoHistoricTrades(btcusd, day("2021-01-04"), &polygonio.CryptoTradesOptions{Limit: 1})
+	if err != nil || len(*first) != 1 {
+		t.Fatalf("unexpected first page %+v, %v", first, err)
+	}
+	second, err := client.CryptoHistoricTrades(btcusd, day("2021-01-04"), &polygonio.CryptoTradesOptions{Offset: (*first)[0].Time, Limit: 2})
+	if err != nil || len(*second) != 2 || (*second)[0].Time != (*first)[0].Time || (*second)[1].Price != 31010 {
+		t.Fatalf("unexpected second page %+v, %v", second, err)
+	}
+	last, err := client.CryptoLastTradeForCryptoPair(btcusd)
+	if err != nil || last.Price != 31020 {
+		t.Fatalf("unexpected last trade %+v, %v", last, err)
+	}
+	book, err := client.CryptoSnapshotFullBook(btcusd.CryptoTicker())
+	if err != nil || len(book.Bids) != 1 || book.Bids[0].Size() != 2 || book.Spread != 5 {
+		t.Fatalf("unexpected book %+v, %v", book, err)
+	}
+	if _, err := client.CryptoSnapshotFullBook("X:NOPE"); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestServerOptions(t *testing.T) {
+	symbol, _ := polygonio.ParseOptionSymbol("O:AAPL210917C00150000")
+	var chain polygonio.OptionSnapshots
+	for i := 0; i < 25; i++ {
+		typ := polygonio.Call
+		if i%2 == 1 {
+			typ = polygonio.Put
+		}
+		chain = append(chain, polygonio.OptionSnapshot{Details: polygonio.OptionContract{
+			ContractType: typ, ExpirationDate: "2021-09-17", StrikePrice: float64(100 + i),
+		}})
+	}
+	base := day("2021-09-01")
+	var trades polygonio.Trades
+	for i := 0; i < 5; i++ {
+		trades = append(trades, polygonio.Trade{SIPTime: base.Add(time.Duration(i) * time.Hour).UnixNano(), Price: float64(i), TradeID: "t"})
+	}
+	srv := NewServer(Fixtures{
+		OptionContracts: map[string]polygonio.OptionContract{symbol.Ticker(): {Ticker: symbol.Ticker(), UnderlyingTicker: "AAPL", StrikePrice: 150}},
+		OptionChains:    map[string]polygonio.OptionSnapshots{"AAPL": chain},
+		Trades:          map[string]polygonio.Trades{symbol.Ticker(): trades},
+	})
+	defer srv.Close()
+	client := srv.PolygonClient()
+
+	contract, err := client.OptionsContract(symbol)
+	if err != nil || contract.UnderlyingTicker != "AAPL" || contract.StrikePrice != 150 {
+		t.Fatalf("unexpected contract %+v, %v", contract, err)
+	}
+	symbol.Strike = 155
+	if _, err := client.OptionsContract(symbol); !polygonio.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	all, err := client.OptionsChainSnapshot("AAPL", nil)
+	if err != nil || len(all) != 25 {
+		t.Fatalf("unexpected chain of %d snapshots, %v", len(all), err)
+	}
+	puts, err := client.OptionsChainSnapshot("AAPL", &polygonio.OptionsChainOptions{ContractType: polygonio.Put, Limit: 5})
+	if err != nil || len(puts) != 12 || puts[11].Details.StrikePrice != 123 {
+		t.Fatalf("unexpected puts %+v, %v", puts, err)
+	}
+
+	symbol.Strike = 150
+	it := client.OptionsTradesIter(context.Background(), symbol, &polygonio.V3TickOptions{Timestamp: "2021-09-01", Order: polygonio.Desc, Limit: 2})
+	var prices []float64
+	for it.Next() {
+		prices = append(prices, it.Value().Price)
+	}
+	if err := it.Err(); err != nil || len(prices) != 5 || prices[0] != 4 || prices[4] != 0 {
+		t.Fatalf("unexpected prices %v, %v", prices, err)
+	}
+}
+
+func TestServerIndices(t *testing.T) {
+	base := day("2021-01-04")
+	fx := Fixtures{
+		Bars: map[string]polygonio.Bars{"I:SPX": {
+			{Time: base.UnixNano() / 1e6, Close: 3700},
+			{Time: base.AddDate(0, 0, 1).UnixNano() / 1e6, Close: 3726},
+			{Time: base.AddDate(0, 0, 2).UnixNano() / 1e6, Close: 3748},
+		}},
+		IndexSnapshots: polygonio.IndexSnapshots{{Ticker: "I:SPX", Value: 3726}},
+	}
+	srv := NewServer(fx)
+	defer srv.Close()
+
+	for _, client := range []polygonio.IndicesAPI{srv.PolygonClient(), &FakeClient{Fixtures: fx}} {
+		// the range holds more bars than the limit, it is fetched in chunks
+		bars, err := client.IndexAggregates("SPX", 1, polygonio.Day, base, base.AddDate(0, 0, 2), &polygonio.RequestOptions{Limit: 2})
+		if err != nil || len(*bars) != 3 || (*bars)[0].Close != 3700 || (*bars)[2].Close != 3748 {
+			t.Fatalf("%T: unexpected bars %+v, %v", client, bars, err)
+		}
+		prev, err := client.IndexPreviousClose("I:SPX", nil)
+		if err != nil || len(*prev) != 1 || (*prev)[0].Close != 3748 {
+			t.Fatalf("%T: unexpected previous close %+v, %v", client, prev, err)
+		}
+		snapshots, err := client.IndexSnapshot("SPX", "DJI")
+		if err != nil || len(snapshots) != 2 || snapshots[0].Value != 3726 || snapshots[1].Error == "" {
+			t.Fatalf("%T: unexpected snapshots %+v, %v", client, snapshots, err)
+		}
+	}
+}
+
+func TestServerV3Ticks(t *testing.T) {
+	base := day("2021-01-04")
+	var quotes polygonio.Quotes
+	for i := 0; i < 10; i++ {
+		quotes = append(quotes, polygonio.Quote{SIPTime: base.Add(time.Duration(i) * time.Minute).UnixNano(), BidPrice: float64(100 + i)})
+	}
+	fx := fixtures()
+	fx.Quotes = map[string]polygonio.Quotes{"AAPL": quotes}
+	srv := NewServer(fx)
+	defer srv.Close()
+
+	gte := strconv.FormatInt(base.Add(2*time.Minute).UnixNano(), 10)
+	lt := strconv.FormatInt(base.Add(7*time.Minute).UnixNano(), 10)
+	for _, client := range []polygonio.StocksAPI{srv.PolygonClient(), &FakeClient{Fixtures: fx}} {
+		var prices []float64
+		opts := &polygonio.V3TickOptions{TimestampGTE: gte, TimestampLT: lt, Order: polygonio.Desc, Limit: 2}
+		for {
+			trades, cursor, err := client.StockTradesV3("AAPL", opts)
+			if err != nil {
+				t.Fatalf("%T: %v", client, err)
+			}
+			for _, tr := range trades {
+				prices = append(prices, tr.Price)
+			}
+			if cursor == "" {
+				break
+			}
+			opts = &polygonio.V3TickOptions{Cursor: cursor}
+		}
+		if fmt.Sprint(prices) != "[106 105 104 103 102]" {
+			t.Fatalf("%T: unexpected prices %v", client, prices)
+		}
+
+		quotes, cursor, err := client.StockQuotesV3("AAPL", &polygonio.V3TickOptions{Timestamp: "2021-01-04", TimestampGT: strconv.FormatInt(base.Add(8*time.Minute).UnixNano(), 10)})
+		if err != nil || len(quotes) != 1 || quotes[0].BidPrice != 109 || cursor != "" {
+			t.Fatalf("%T: unexpected quotes %+v, %q, %v", client, quotes, cursor, err)
+		}
+		if _, _, err := client.StockQuotesV3("AAPL", &polygonio.V3TickOptions{TimestampLTE: "yesterday"}); err == nil {
+			t.Fatalf("%T: expected an error for an invalid timestamp", client)
+		}
+	}
+
+	it := srv.PolygonClient().StockQuotesV3Iter(context.Background(), "AAPL", &polygonio.V3TickOptions{TimestampLTE: "2021-01-04", Limit: 3})
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil || n != 10 {
+		t.Fatalf("iterated over %d quotes, %v", n, err)
+	}
+}
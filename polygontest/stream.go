@@ -0,0 +1,228 @@
+package polygontest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+// StreamServer is a fake of the Polygon WebSocket API. It speaks the
+// connected, auth, subscribe and unsubscribe protocol used by polygonio.Stream
+// and lets tests push messages and break connections at will.
+type StreamServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	apiKey      string
+	rejectAuth  bool
+	conns       []*streamConn
+	connections int
+}
+
+type streamConn struct {
+	sync.Mutex // guards writes
+	ws         *websocket.Conn
+	authed     bool
+	subs       map[string]bool
+}
+
+var upgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+// NewStreamServer starts a StreamServer accepting apiKey, or any key if
+// apiKey is empty.
+func NewStreamServer(apiKey string) *StreamServer {
+	s := &StreamServer{apiKey: apiKey}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveWS))
+	return s
+}
+
+// Endpoint returns the ws:// URL to pass to polygonio.NewStream.
+func (s *StreamServer) Endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// RejectAuth makes the server fail every following auth request.
+func (s *StreamServer) RejectAuth(reject bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectAuth = reject
+}
+
+// Connections returns the number of connections accepted so far.
+func (s *StreamServer) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections
+}
+
+// Subscriptions returns the channels subscribed on open connections.
+func (s *StreamServer) Subscriptions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []string
+	for _, c := range s.conns {
+		c.Lock()
+		for sub := range c.subs {
+			out = append(out, sub)
+		}
+		c.Unlock()
+	}
+	return out
+}
+
+// WaitFor polls cond until it holds or timeout passes. Stream.Subscribe does
+// not wait for an acknowledgement, so tests use it to synchronize with the
+// server, e.g. s.WaitFor(func() bool { return len(s.Subscriptions()) == 1 }, time.Second).
+func (s *StreamServer) WaitFor(cond func() bool, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return true
+}
+
+// Push sends msgs as a single JSON array to every authenticated connection,
+// e.g. a polygonio.StreamTrades or polygonio.StreamAggregates value.
+func (s *StreamServer) Push(msgs interface{}) error {
+	b, err := json.Marshal(msgs)
+	if err != nil {
+		return err
+	}
+	return s.PushRaw(b)
+}
+
+// PushRaw sends a raw text frame to every authenticated connection.
+func (s *StreamServer) PushRaw(b []byte) error {
+	sent := false
+	for _, c := range s.open() {
+		c.Lock()
+		if c.authed {
+			if err := c.ws.WriteMessage(websocket.TextMessage, b); err != nil {
+				c.Unlock()
+				return err
+			}
+			sent = true
+		}
+		c.Unlock()
+	}
+	if !sent {
+		return errors.New("polygontest: no authenticated stream connection")
+	}
+	return nil
+}
+
+// CloseConnections sends a close frame with code and text on every
+// connection and closes it.
+func (s *StreamServer) CloseConnections(code int, text string) {
+	for _, c := range s.open() {
+		c.Lock()
+		c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+		c.ws.Close()
+		c.Unlock()
+	}
+}
+
+// DropConnections closes every connection without a close frame, as a
+// network failure would.
+func (s *StreamServer) DropConnections() {
+	for _, c := range s.open() {
+		c.ws.UnderlyingConn().Close()
+	}
+}
+
+func (s *StreamServer) open() []*streamConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*streamConn{}, s.conns...)
+}
+
+func (s *StreamServer) remove(c *streamConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.conns {
+		if s.conns[i] == c {
+			s.conns = append(s.conns[:i], s.conns[i+1:]...)
+			return
+		}
+	}
+}
+
+func status(status, message string) []polygonio.PolygonAuthMsg {
+	return []polygonio.PolygonAuthMsg{{Event: "status", Status: status, Message: message}}
+}
+
+func (s *StreamServer) serveWS(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &streamConn{ws: ws, subs: make(map[string]bool)}
+	s.mu.Lock()
+	s.conns = append(s.conns, c)
+	s.connections++
+	s.mu.Unlock()
+	defer func() {
+		s.remove(c)
+		ws.Close()
+	}()
+
+	if err := c.write(status("connected", "Connected Successfully")); err != nil {
+		return
+	}
+	for {
+		var msg polygonio.PolygonClientMsg
+		if err := ws.ReadJSON(&msg); err != nil {
+			return
+		}
+		switch msg.Action {
+		case "auth":
+			s.mu.Lock()
+			accept := !s.rejectAuth && msg.Params != "" && (s.apiKey == "" || s.apiKey == msg.Params)
+			s.mu.Unlock()
+			if !accept {
+				c.write(status("auth_failed", "authentication failed"))
+				return
+			}
+			c.Lock()
+			c.authed = true
+			c.Unlock()
+			c.write(status("auth_success", "authenticated"))
+		case "subscribe", "unsubscribe":
+			c.Lock()
+			authed := c.authed
+			c.Unlock()
+			if !authed {
+				c.write(status("error", "not authorized"))
+				continue
+			}
+			for _, channel := range strings.Split(msg.Params, ",") {
+				c.Lock()
+				if msg.Action == "subscribe" {
+					c.subs[channel] = true
+				} else {
+					delete(c.subs, channel)
+				}
+				c.Unlock()
+				c.write(status("success", msg.Action+"d to: "+channel))
+			}
+		default:
+			c.write(status("error", "unknown action"))
+		}
+	}
+}
+
+func (c *streamConn) write(v interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.ws.WriteJSON(v)
+}
@@ -0,0 +1,102 @@
+package polygontest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	polygonio "github.com/gtmk/polygon-gclient"
+)
+
+// nextTrades returns the next trade message, skipping status messages.
+func nextTrades(t *testing.T, s *polygonio.Stream) polygonio.StreamTrades {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case msg := <-s.MessageC:
+			events, err := polygonio.ParseEvents(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) > 0 && events[0].Event == "T" {
+				trades, err := polygonio.ParseStreamTrades(msg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return trades
+			}
+		case err := <-s.ErrorC:
+			t.Fatal(err)
+		case <-timeout:
+			t.Fatal("timed out waiting for trades")
+		}
+	}
+}
+
+func TestStreamLifecycle(t *testing.T) {
+	srv := NewStreamServer("KEY")
+	defer srv.Close()
+
+	stream, err := polygonio.NewStream("KEY", srv.Endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Subscribe("T.AAPL"); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.WaitFor(func() bool { return len(srv.Subscriptions()) == 1 }, time.Second) {
+		t.Fatal("subscription never reached the server")
+	}
+	if err := srv.Push(polygonio.StreamTrades{{Event: "T", Symbol: "AAPL", Price: 130.5, Size: 100}}); err != nil {
+		t.Fatal(err)
+	}
+	if trades := nextTrades(t, stream); trades[0].Symbol != "AAPL" || trades[0].Price != 130.5 {
+		t.Fatalf("unexpected trades %+v", trades)
+	}
+
+	// the stream reconnects and authenticates again after a close frame
+	srv.CloseConnections(websocket.CloseGoingAway, "restart")
+	if !srv.WaitFor(func() bool {
+		return srv.Connections() == 2 && srv.Push(polygonio.StreamTrades{{Event: "T", Symbol: "MSFT"}}) == nil
+	}, time.Second) {
+		t.Fatal("stream did not reconnect")
+	}
+	if trades := nextTrades(t, stream); trades[0].Symbol != "MSFT" {
+		t.Fatalf("unexpected trades %+v", trades)
+	}
+
+	if err := stream.Unsubscribe("T.AAPL"); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamRejectedAuth(t *testing.T) {
+	srv := NewStreamServer("KEY")
+	defer srv.Close()
+
+	if _, err := polygonio.NewStream("WRONG", srv.Endpoint()); err == nil {
+		t.Fatal("expected auth to fail")
+	}
+	srv.RejectAuth(true)
+	if _, err := polygonio.NewStream("KEY", srv.Endpoint()); err == nil {
+		t.Fatal("expected auth to fail")
+	}
+}
+
+func TestStreamDroppedConnection(t *testing.T) {
+	srv := NewStreamServer("")
+	defer srv.Close()
+
+	stream, err := polygonio.NewStream("KEY", srv.Endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.DropConnections()
+	if !srv.WaitFor(func() bool { return srv.Connections() == 2 }, time.Second) {
+		t.Fatal("stream did not reconnect")
+	}
+	stream.Close()
+}
@@ -0,0 +1,94 @@
+package polygonio
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the Client to the given number of requests per
+// interval, e.g. WithRateLimit(5, time.Minute) for the free plan. The limit is
+// a token bucket shared by every method of the Client; calls block until a
+// token is available or their context is done.
+func WithRateLimit(requests int, per time.Duration) func(*Client) {
+	return func(client *Client) {
+		client.limiter = newRateLimiter(requests, per)
+	}
+}
+
+// WithEndpointWeight makes every call of the given class consume weight tokens
+// of the rate limit instead of one.
+func WithEndpointWeight(class EndpointClass, weight int) func(*Client) {
+	return func(client *Client) {
+		if client.weights == nil {
+			client.weights = make(map[EndpointClass]int)
+		}
+		client.weights[class] = weight
+	}
+}
+
+type rateLimiter struct {
+	sync.Mutex
+	capacity float64
+	tokens   float64
+	rate     float64 // tokens per second
+	last     time.Time
+}
+
+func newRateLimiter(requests int, per time.Duration) *rateLimiter {
+	if requests <= 0 || per <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		capacity: float64(requests),
+		tokens:   float64(requests),
+		rate:     float64(requests) / per.Seconds(),
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until n tokens are available or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	need := float64(n)
+	if need > l.capacity {
+		need = l.capacity
+	}
+	for {
+		l.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+		l.last = now
+		if l.tokens >= need {
+			l.tokens -= need
+			l.Unlock()
+			return nil
+		}
+		delay := time.Duration((need - l.tokens) / l.rate * float64(time.Second))
+		l.Unlock()
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (c *Client) waitRateLimit(ctx context.Context, address string) error {
+	if c.limiter == nil {
+		return nil
+	}
+	weight, ok := c.weights[lookupRoute(address).class]
+	if !ok {
+		weight = 1
+	}
+	if weight <= 0 {
+		return nil
+	}
+	return c.limiter.wait(ctx, weight)
+}
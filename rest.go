@@ -1,12 +1,17 @@
 package polygonio
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -23,22 +28,31 @@ const apiURL = "https://api.polygon.io"
 type Client struct {
 	baseURL    string
 	token      string
+	authHeader bool
 	httpClient *http.Client
-}
+	transport  http.RoundTripper
+	retry      RetryPolicy
+	limiter    *rateLimiter
+	weights    map[EndpointClass]int
 
-type Error struct {
-	StatusCode int    `json:"code"`
-	Message    string `json:"message"`
-}
+	chunkConcurrency int
+
+	noCompression bool
+	stats         *transferCounters
 
-func (e Error) Error() string {
-	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	middlewares []Middleware
+	doer        Doer
+
+	cache     Cache
+	cacheTTLs map[EndpointClass]time.Duration
+	offline   bool
 }
 
 func NewClient(token string, options ...func(*Client)) *Client {
 	client := &Client{
 		token:      token,
 		httpClient: &http.Client{},
+		stats:      &transferCounters{},
 	}
 
 	// apply options
@@ -50,6 +64,12 @@ func NewClient(token string, options ...func(*Client)) *Client {
 	if client.baseURL == "" {
 		client.baseURL = apiURL
 	}
+	if client.transport != nil {
+		httpClient := *client.httpClient
+		httpClient.Transport = client.transport
+		client.httpClient = &httpClient
+	}
+	client.buildDoer()
 	return client
 }
 
@@ -59,12 +79,28 @@ func WithHTTPClient(httpClient *http.Client) func(*Client) {
 	}
 }
 
+// WithTransport sets the http.RoundTripper of the Client's http.Client,
+// including one given with WithHTTPClient, which is left unmodified.
+func WithTransport(transport http.RoundTripper) func(*Client) {
+	return func(client *Client) {
+		client.transport = transport
+	}
+}
+
 func WithBaseURL(baseURL string) func(*Client) {
 	return func(client *Client) {
 		client.baseURL = baseURL
 	}
 }
 
+// WithAuthHeader sends the API key in an "Authorization: Bearer" header
+// instead of the apiKey query parameter, keeping it out of request URLs.
+func WithAuthHeader() func(*Client) {
+	return func(client *Client) {
+		client.authHeader = true
+	}
+}
+
 func (c *Client) GetJSON(ctx context.Context, endpoint string, v interface{}) error {
 	address, err := c.addToken(endpoint)
 	if err != nil {
@@ -86,26 +122,120 @@ func (c *Client) GetBytes(ctx context.Context, endpoint string) ([]byte, error)
 }
 
 func (c *Client) getBytes(ctx context.Context, address string) ([]byte, error) {
-	req, err := http.NewRequest("GET", address, nil)
+	if c.offline {
+		return c.offlineGet(address)
+	}
+	if data, ok := c.cacheGet(address); ok {
+		return data, nil
+	}
+	var data []byte
+	err := c.withRetry(ctx, func() (time.Duration, *Error) {
+		body, retryAfter, e := c.open(ctx, address)
+		if e != nil {
+			return retryAfter, e
+		}
+		defer body.Close()
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return 0, &Error{Err: c.redactError(err)}
+		}
+		data = b
+		return 0, nil
+	})
 	if err != nil {
 		return []byte{}, err
 	}
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	c.cacheSet(address, data)
+	return data, nil
+}
+
+// getBody returns the body of a successful response for the caller to
+// consume. Only opening the response is retried, reading from the body is
+// not. Cached responses are served but, as the body is never held in
+// memory, new ones are not stored.
+func (c *Client) getBody(ctx context.Context, address string) (io.ReadCloser, error) {
+	if c.offline {
+		data, err := c.offlineGet(address)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	if data, ok := c.cacheGet(address); ok {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	var body io.ReadCloser
+	err := c.withRetry(ctx, func() (time.Duration, *Error) {
+		b, retryAfter, e := c.open(ctx, address)
+		body = b
+		return retryAfter, e
+	})
 	if err != nil {
-		return []byte{}, err
+		return nil, err
+	}
+	return body, nil
+}
+
+// withRetry runs attempt until it succeeds or the retry policy gives up.
+func (c *Client) withRetry(ctx context.Context, attempt func() (time.Duration, *Error)) error {
+	n := 0
+	for {
+		n++
+		retryAfter, err := attempt()
+		if err == nil {
+			return nil
+		}
+		if n >= c.retry.MaxAttempts || !c.retry.shouldRetry(ctx, *err) ||
+			!sleep(ctx, c.retry.delay(n, retryAfter)) {
+			err.Attempts = n
+			return *err
+		}
+	}
+}
+
+// open sends a single GET request and returns the body of a 200 response.
+// Any other status is turned into an Error along with its Retry-After delay.
+func (c *Client) open(ctx context.Context, address string) (io.ReadCloser, time.Duration, *Error) {
+	if err := c.waitRateLimit(ctx, address); err != nil {
+		return nil, 0, &Error{Err: err}
+	}
+	req, err := http.NewRequest("GET", address, nil)
+	if err != nil {
+		return nil, 0, &Error{Err: c.redactError(err)}
+	}
+	if c.authHeader {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	c.acceptGzip(req)
+	resp, err := c.doer.Do(req.WithContext(c.withRequestInfo(ctx, address)))
+	if err != nil {
+		return nil, 0, &Error{Err: c.redactError(err)}
+	}
+	body, err := c.decodeBody(resp)
+	if err != nil {
+		// the body cannot be read, keep what the status line and headers tell
+		e := newError(resp, "")
+		e.Err = err
+		e.Endpoint = c.redact(req.URL.RequestURI())
+		if resp.StatusCode == http.StatusOK {
+			return nil, 0, e
+		}
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), e
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		b, err := ioutil.ReadAll(resp.Body)
+		defer body.Close()
+		b, err := ioutil.ReadAll(body)
 		msg := ""
 
 		if err == nil {
-			msg = string(b)
+			msg = c.redact(string(b))
 		}
 
-		return []byte{}, Error{StatusCode: resp.StatusCode, Message: msg}
+		e := newError(resp, msg)
+		e.Endpoint = c.redact(req.URL.RequestURI())
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), e
 	}
-	return ioutil.ReadAll(resp.Body)
+	return body, 0, nil
 }
 
 func (c *Client) addToken(endpoint string) (string, error) {
@@ -113,12 +243,44 @@ func (c *Client) addToken(endpoint string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if c.authHeader {
+		return u.String(), nil
+	}
 	v := u.Query()
-	v.Add("apiKey", c.token)
+	v.Set("apiKey", c.token)
 	u.RawQuery = v.Encode()
 	return u.String(), nil
 }
 
+const redacted = "REDACTED"
+
+// redact replaces every occurrence of the API key in s.
+func (c *Client) redact(s string) string {
+	if c.token == "" {
+		return s
+	}
+	s = strings.Replace(s, c.token, redacted, -1)
+	// the key may also appear query escaped inside a URL
+	if escaped := url.QueryEscape(c.token); escaped != c.token {
+		s = strings.Replace(s, escaped, redacted, -1)
+	}
+	return s
+}
+
+// redactError strips the API key from errors produced by net/http, which
+// include the full request URL.
+func (c *Client) redactError(err error) error {
+	if ue, ok := err.(*url.Error); ok {
+		return &url.Error{Op: ue.Op, URL: c.redact(ue.URL), Err: c.redactError(ue.Err)}
+	}
+	if msg := err.Error(); c.redact(msg) != msg {
+		// the original error is dropped on purpose so that it cannot be
+		// unwrapped and logged with the key
+		return errors.New(c.redact(msg))
+	}
+	return err
+}
+
 ////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////
 ////////               Reference Endpoints                      ////////////
@@ -126,6 +288,10 @@ func (c *Client) addToken(endpoint string) (string, error) {
 ////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////
 func (c *Client) ReferenceTickers(opts *TickerOptions) (Tickers, error) {
+	return c.ReferenceTickersContext(context.Background(), opts)
+}
+
+func (c *Client) ReferenceTickersContext(ctx context.Context, opts *TickerOptions) (Tickers, error) {
 	out := struct {
 		Tickers Tickers `json:"tickers"`
 	}{}
@@ -134,7 +300,7 @@ func (c *Client) ReferenceTickers(opts *TickerOptions) (Tickers, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = c.GetJSON(context.Background(), endpoint, &out)
+	err = c.GetJSON(ctx, endpoint, &out)
 	return out.Tickers, err
 }
 
@@ -154,6 +320,10 @@ func (c *Client) referenceTickersWithOpts(endpoint string, opts *TickerOptions)
 }
 
 func (c *Client) ReferenceTickerTypes() (map[string]string, map[string]string, error) {
+	return c.ReferenceTickerTypesContext(context.Background())
+}
+
+func (c *Client) ReferenceTickerTypesContext(ctx context.Context) (map[string]string, map[string]string, error) {
 	out := struct {
 		Results struct {
 			Types      map[string]string `json:"types"`
@@ -161,25 +331,33 @@ func (c *Client) ReferenceTickerTypes() (map[string]string, map[string]string, e
 		} `json:"results"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/reference/types")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Results.Types, out.Results.IndexTypes, err
 }
 
 func (c *Client) ReferenceTickerDetail(ticker string) (TickerDetails, error) {
+	return c.ReferenceTickerDetailContext(context.Background(), ticker)
+}
+
+func (c *Client) ReferenceTickerDetailContext(ctx context.Context, ticker string) (TickerDetails, error) {
 	var out TickerDetails
 	endpoint := fmt.Sprintf("/v1/meta/symbols/%s/company", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
 func (c *Client) ReferenceTickerNews(ticker string, opts *NewsOptions) ([]TickerNews, error) {
+	return c.ReferenceTickerNewsContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) ReferenceTickerNewsContext(ctx context.Context, ticker string, opts *NewsOptions) ([]TickerNews, error) {
 	var out []TickerNews
 	endpoint := fmt.Sprintf("/v1/meta/symbols/%s/news", url.PathEscape(ticker))
 	endpoint, err := c.referenceTickerNewsWithOpts(endpoint, opts)
 	if err != nil {
 		return out, err
 	}
-	err = c.GetJSON(context.Background(), endpoint, &out)
+	err = c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
@@ -199,42 +377,62 @@ func (c *Client) referenceTickerNewsWithOpts(endpoint string, opts *NewsOptions)
 }
 
 func (c *Client) ReferenceMarkets() (MarketDescriptions, error) {
+	return c.ReferenceMarketsContext(context.Background())
+}
+
+func (c *Client) ReferenceMarketsContext(ctx context.Context) (MarketDescriptions, error) {
 	out := struct {
 		Results MarketDescriptions `json:"results"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/reference/markets")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Results, err
 }
 
 func (c *Client) ReferenceLocales() (LocaleNames, error) {
+	return c.ReferenceLocalesContext(context.Background())
+}
+
+func (c *Client) ReferenceLocalesContext(ctx context.Context) (LocaleNames, error) {
 	out := struct {
 		Results LocaleNames `json:"results"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/reference/locales")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Results, err
 }
 
 func (c *Client) ReferenceStockSplits(ticker string) (Splits, error) {
+	return c.ReferenceStockSplitsContext(context.Background(), ticker)
+}
+
+func (c *Client) ReferenceStockSplitsContext(ctx context.Context, ticker string) (Splits, error) {
 	out := struct {
 		Results Splits `json:"results"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/reference/splits/%s", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Results, err
 }
 
 func (c *Client) ReferenceDividends(ticker string) (Dividends, error) {
+	return c.ReferenceDividendsContext(context.Background(), ticker)
+}
+
+func (c *Client) ReferenceDividendsContext(ctx context.Context, ticker string) (Dividends, error) {
 	out := struct {
 		Results Dividends `json:"results"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/reference/dividends/%s", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Results, err
 }
 
 func (c *Client) ReferenceFinancials(ticker string, opts *FinancialOptions) (Financials, error) {
+	return c.ReferenceFinancialsContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) ReferenceFinancialsContext(ctx context.Context, ticker string, opts *FinancialOptions) (Financials, error) {
 	out := struct {
 		Results Financials `json:"results"`
 	}{}
@@ -243,7 +441,7 @@ func (c *Client) ReferenceFinancials(ticker string, opts *FinancialOptions) (Fin
 	if err != nil {
 		return nil, err
 	}
-	err = c.GetJSON(context.Background(), endpoint, &out)
+	err = c.GetJSON(ctx, endpoint, &out)
 	return out.Results, err
 }
 
@@ -263,15 +461,23 @@ func (c *Client) referenceFinancialsWithOpts(endpoint string, opts *FinancialOpt
 }
 
 func (c *Client) ReferenceMarketStatus() (MarketStatus, error) {
+	return c.ReferenceMarketStatusContext(context.Background())
+}
+
+func (c *Client) ReferenceMarketStatusContext(ctx context.Context) (MarketStatus, error) {
 	var out MarketStatus
 	endpoint := fmt.Sprintf("/v1/marketstatus/now")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
 func (c *Client) ReferenceMarketHolidays() (MarketHolidays, error) {
+	return c.ReferenceMarketHolidaysContext(context.Background())
+}
+
+func (c *Client) ReferenceMarketHolidaysContext(ctx context.Context) (MarketHolidays, error) {
 	var out MarketHolidays
 	endpoint := fmt.Sprintf("/v1/marketstatus/upcoming")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
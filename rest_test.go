@@ -0,0 +1,357 @@
+package polygonio
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStockDailyTradesContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// every page is full and advances the cursor, so only the context can stop
+		// the loop
+		fmt.Fprintf(w, `{"results":[{"t":%d},{"t":%d}]}`, calls*10, calls*10+1)
+		if calls == 2 {
+			cancel()
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+	_, err := client.StockDailyTradesContext(ctx, "AAPL", date("2020-10-05"), &RequestOptions{Limit: 2})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls before cancellation, got %d", calls)
+	}
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			fmt.Fprint(w, `{"market":"open"}`)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	status, err := client.ReferenceMarketStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Market != "open" || calls != 3 {
+		t.Fatalf("unexpected result %+v after %d calls", status, calls)
+	}
+}
+
+func TestRetryExhaustedReportsAttempts(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	_, err := client.ReferenceMarketStatus()
+	var e Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected Error, got %T", err)
+	}
+	if e.Attempts != 3 || calls != 3 || e.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected error %+v after %d calls", e, calls)
+	}
+}
+
+func TestRetrySkipsClientErrors(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithRetry(DefaultRetryPolicy))
+	if _, err := client.ReferenceMarketStatus(); err == nil || calls != 1 {
+		t.Fatalf("expected a single failed call, got %d calls and err %v", calls, err)
+	}
+}
+
+func TestRetrySkipsNotImplemented(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotImplemented)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if _, err := client.ReferenceMarketStatus(); err == nil || calls != 1 {
+		t.Fatalf("expected a single failed call, got %d calls and err %v", calls, err)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	_, badRequest := http.NewRequest("GET", "http://host/%zz", nil)
+	_, refused := http.Get("http://127.0.0.1:0/")
+	for _, tc := range []struct {
+		err  Error
+		want bool
+	}{
+		{Error{StatusCode: http.StatusTooManyRequests}, true},
+		{Error{StatusCode: http.StatusInternalServerError}, true},
+		{Error{StatusCode: http.StatusGatewayTimeout}, true},
+		{Error{StatusCode: http.StatusNotImplemented}, false},
+		{Error{StatusCode: http.StatusHTTPVersionNotSupported}, false},
+		{Error{StatusCode: http.StatusNotFound}, false},
+		{Error{Err: refused}, true},
+		{Error{Err: fmt.Errorf("read body: %w", io.ErrUnexpectedEOF)}, true},
+		{Error{Err: badRequest}, false},
+		{Error{Err: errors.New("rate limit")}, false},
+	} {
+		if got := DefaultRetryPolicy.shouldRetry(context.Background(), tc.err); got != tc.want {
+			t.Errorf("%v: expected %v, got %v", tc.err, tc.want, got)
+		}
+	}
+}
+
+func TestRateLimitBlocksUntilContextDone(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithRateLimit(2, time.Hour), WithEndpointWeight(ClassBulk, 2))
+	if _, err := client.ReferenceMarketStatus(); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	// one token left, the bulk snapshot needs two
+	if _, err := client.StockSnapshotAllContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if _, err := client.ReferenceMarketStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestLookupRoute(t *testing.T) {
+	cases := map[string]EndpointClass{
+		"/v2/snapshot/locale/us/markets/stocks/tickers":              ClassBulk,
+		"/v2/snapshot/locale/us/markets/stocks/tickers/AAPL":         ClassSnapshot,
+		"/v2/snapshot/locale/us/markets/stocks/gainers":              ClassSnapshot,
+		"/v2/aggs/ticker/AAPL/range/1/day/2020-01-01/2020-02-01?x=1": ClassAggregates,
+		"/v1/open-close/crypto/BTC/USD/2020-10-05":                   ClassAggregates,
+		"/v1/meta/symbols/AAPL/company":                              ClassReference,
+		"/v9/unknown":                                                ClassOther,
+	}
+	for endpoint, class := range cases {
+		if got := lookupRoute(endpoint).class; got != class {
+			t.Errorf("%s: expected %s, got %s", endpoint, class, got)
+		}
+	}
+}
+
+func TestAuthHeaderKeepsKeyOutOfURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apiKey") != "" || r.Header.Get("Authorization") != "Bearer SECRET" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient("SECRET", WithBaseURL(srv.URL), WithAuthHeader())
+	if _, err := client.ReferenceMarketStatus(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrorsRedactKey(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"bad request %s"}`, r.URL.String())
+	}))
+	client := NewClient("SECRET", WithBaseURL(srv.URL))
+	_, err := client.ReferenceMarketStatus()
+	if err == nil || strings.Contains(err.Error(), "SECRET") {
+		t.Fatalf("expected redacted error, got %v", err)
+	}
+
+	// transport errors carry the request URL
+	srv.Close()
+	_, err = client.ReferenceMarketStatus()
+	if err == nil || strings.Contains(err.Error(), "SECRET") {
+		t.Fatalf("expected redacted error, got %v", err)
+	}
+	var ue *url.Error
+	if !errors.As(err, &ue) || strings.Contains(ue.URL, "SECRET") {
+		t.Fatalf("expected redacted url.Error, got %v", err)
+	}
+}
+
+func TestErrorTaxonomy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/meta/symbols/NOPE/company":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status":"NOT_FOUND","request_id":"abc123","message":"ticker not found"}`)
+		case "/v1/marketstatus/now":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"status":"NOT_AUTHORIZED","request_id":"def456","error":"not entitled"}`)
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `slow down`)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	_, err := client.ReferenceTickerDetail("NOPE")
+	var e Error
+	if !IsNotFound(err) || !errors.As(err, &e) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if e.RequestID != "abc123" || e.Message != "ticker not found" || e.Status != "NOT_FOUND" {
+		t.Fatalf("unexpected error fields %+v", e)
+	}
+	if e.Endpoint != "/v1/meta/symbols/NOPE/company?apiKey=REDACTED" {
+		t.Fatalf("unexpected endpoint %s", e.Endpoint)
+	}
+
+	_, err = client.ReferenceMarketStatus()
+	if !IsNotAuthorized(err) || IsNotFound(err) || !strings.Contains(err.Error(), "not entitled") {
+		t.Fatalf("expected not authorized, got %v", err)
+	}
+
+	_, err = client.StockExchanges()
+	if !errors.Is(err, ErrRateLimited) || !strings.Contains(err.Error(), "slow down") {
+		t.Fatalf("expected rate limited, got %v", err)
+	}
+}
+
+func TestGzipResponses(t *testing.T) {
+	payload := `{"results":[` + strings.Repeat(`{"t":1,"p":1.5,"s":100},`, 1000) + `{"t":2}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			fmt.Fprint(w, payload)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		fmt.Fprint(zw, payload)
+		zw.Close()
+	}))
+	defer srv.Close()
+
+	// a custom transport must not change the outcome
+	client := NewClient("KEY", WithBaseURL(srv.URL), WithHTTPClient(&http.Client{Transport: &http.Transport{}}))
+	trades, err := client.StockTrades("AAPL", date("2020-10-05"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*trades) != 1001 {
+		t.Fatalf("expected 1001 trades, got %d", len(*trades))
+	}
+	stats := client.TransferStats()
+	if stats.DecodedBytes != int64(len(payload)) || stats.WireBytes >= stats.DecodedBytes {
+		t.Fatalf("unexpected transfer stats %+v", stats)
+	}
+
+	client = NewClient("KEY", WithBaseURL(srv.URL), WithoutCompression())
+	if _, err := client.StockTrades("AAPL", date("2020-10-05"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if stats := client.TransferStats(); stats.WireBytes != stats.DecodedBytes {
+		t.Fatalf("unexpected transfer stats %+v", stats)
+	}
+}
+
+func TestGzipDecodeErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		if r.URL.Path == "/busy" {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+		fmt.Fprint(w, "this is not a gzip stream")
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	_, _, e := client.open(context.Background(), srv.URL+"/ok")
+	if e == nil || e.StatusCode != http.StatusOK || !strings.Contains(e.Error(), "gzip") {
+		t.Fatalf("expected the gzip error in %v", e)
+	}
+	_, retryAfter, e := client.open(context.Background(), srv.URL+"/busy")
+	if e == nil || !errors.Is(*e, ErrRateLimited) || retryAfter != 7*time.Second || !strings.Contains(e.Error(), "gzip") {
+		t.Fatalf("expected a rate limited gzip error with its Retry-After, got %v after %v", e, retryAfter)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace") != "outer,inner" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"tickers":[{"ticker":"AAPL"}]}`)
+	}))
+	defer srv.Close()
+
+	header := func(value string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				if v := req.Header.Get("X-Trace"); v != "" {
+					value = v + "," + value
+				}
+				req.Header.Set("X-Trace", value)
+				return next.Do(req)
+			})
+		}
+	}
+	var calls []CallStats
+	client := NewClient("SECRET", WithBaseURL(srv.URL),
+		WithMiddleware(header("outer"), header("inner")),
+		WithMiddleware(Observe(func(s CallStats) { calls = append(calls, s) })))
+	if _, err := client.StockSnapshotAll(); err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 {
+		t.Fatalf("expected one observed call, got %d", len(calls))
+	}
+	call := calls[0]
+	if call.Endpoint != "SnapshotAll" || call.Class != ClassBulk || call.StatusCode != http.StatusOK || call.Bytes == 0 {
+		t.Fatalf("unexpected call stats %+v", call)
+	}
+	if strings.Contains(call.URL, "SECRET") || !strings.Contains(call.URL, "apiKey=REDACTED") {
+		t.Fatalf("expected redacted url, got %s", call.URL)
+	}
+}
@@ -0,0 +1,123 @@
+package polygonio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the Client retries GET requests that fail with a
+// 429, a 500, 502, 503 or 504, or a network error. Other failures, such as a
+// malformed request or a 501, would fail again and are returned as is.
+// Delays grow exponentially from BaseDelay and are capped at MaxDelay, with
+// jitter applied to each wait. A Retry-After header sent by the server takes
+// precedence over the computed delay.
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts including the first, values below 2 disable retries
+	BaseDelay   time.Duration // delay before the first retry
+	MaxDelay    time.Duration // upper bound for any single computed delay
+}
+
+// DefaultRetryPolicy is a reasonable policy for long running backfills.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+func WithRetry(policy RetryPolicy) func(*Client) {
+	return func(client *Client) {
+		client.retry = policy
+	}
+}
+
+func (p RetryPolicy) shouldRetry(ctx context.Context, e Error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch e.StatusCode {
+	case 0:
+		return transient(e.Err)
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// transient reports whether err is a network failure, e.g. a connection reset
+// or a body cut short, that may not happen again.
+func transient(err error) bool {
+	if err == nil {
+		return false
+	}
+	// net/http wraps every error in a *url.Error, which is a net.Error itself,
+	// including those of a request that could not be built
+	if ue, ok := err.(*url.Error); ok {
+		err = ue.Err
+	}
+	var ne net.Error
+	return errors.As(err, &ne) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// delay returns how long to wait before the given retry attempt (1 based).
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	// equal jitter: keep half of the delay and randomize the rest
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// sleep waits for d or until ctx is done. It reports false if the wait would
+// run past the context deadline or the context was canceled.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// parseRetryAfter understands both forms of the Retry-After header, delay in
+// seconds and an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,107 @@
+package polygonio
+
+import (
+	"net/url"
+	"strings"
+)
+
+// EndpointClass groups endpoints with similar cost so that they can be
+// budgeted together, see WithEndpointWeight.
+type EndpointClass string
+
+const (
+	ClassReference  EndpointClass = "reference"
+	ClassAggregates EndpointClass = "aggregates"
+	ClassTicks      EndpointClass = "ticks"
+	ClassLast       EndpointClass = "last"
+	ClassSnapshot   EndpointClass = "snapshot"
+	ClassBulk       EndpointClass = "bulk" // whole market in one response, e.g. StockSnapshotAll
+	ClassOther      EndpointClass = "other"
+)
+
+type route struct {
+	name    string // logical endpoint name reported to middlewares
+	pattern string // path with "*" matching any single segment
+	class   EndpointClass
+}
+
+// routes is matched in order, so more specific patterns must come first.
+var routes = []route{
+	{"Tickers", "/v2/reference/tickers", ClassReference},
+	{"TickerTypes", "/v2/reference/types", ClassReference},
+	{"TickerDetails", "/v1/meta/symbols/*/company", ClassReference},
+	{"TickerNews", "/v1/meta/symbols/*/news", ClassReference},
+	{"Markets", "/v2/reference/markets", ClassReference},
+	{"Locales", "/v2/reference/locales", ClassReference},
+	{"StockSplits", "/v2/reference/splits/*", ClassReference},
+	{"StockDividends", "/v2/reference/dividends/*", ClassReference},
+	{"StockFinancials", "/v2/reference/financials/*", ClassReference},
+	{"MarketStatus", "/v1/marketstatus/now", ClassLast}, // changes during the day, not cached by default
+	{"MarketHolidays", "/v1/marketstatus/upcoming", ClassReference},
+	{"Exchanges", "/v1/meta/exchanges", ClassReference},
+	{"CryptoExchanges", "/v1/meta/crypto-exchanges", ClassReference},
+	{"ConditionMappings", "/v1/meta/conditions/*", ClassReference},
+
+	{"PreviousClose", "/v2/aggs/ticker/*/prev", ClassAggregates},
+	{"Aggregates", "/v2/aggs/ticker/*/range/*/*/*/*", ClassAggregates},
+	{"GroupedDaily", "/v2/aggs/grouped/locale/*/market/*/*", ClassBulk},
+	{"CryptoDailyOpenClose", "/v1/open-close/crypto/*/*/*", ClassAggregates},
+	{"DailyOpenClose", "/v1/open-close/*/*", ClassAggregates},
+
+	{"Trades", "/v2/ticks/stocks/trades/*/*", ClassTicks},
+	{"Quotes", "/v2/ticks/stocks/nbbo/*/*", ClassTicks},
+
+	{"LastTrade", "/v1/last/stocks/*", ClassLast},
+	{"LastQuote", "/v1/last_quote/stocks/*", ClassLast},
+
+	{"SnapshotAll", "/v2/snapshot/locale/us/markets/stocks/tickers", ClassBulk},
+	{"SnapshotTicker", "/v2/snapshot/locale/us/markets/stocks/tickers/*", ClassSnapshot},
+	{"SnapshotGainersLosers", "/v2/snapshot/locale/us/markets/stocks/*", ClassSnapshot},
+
+	{"CryptoLastTrade", "/v1/last/crypto/*/*", ClassLast},
+	{"CryptoHistoricTrades", "/v1/historic/crypto/*/*/*", ClassTicks},
+	{"CryptoSnapshotAll", "/v2/snapshot/locale/global/markets/crypto/tickers", ClassBulk},
+	{"CryptoSnapshotBook", "/v2/snapshot/locale/global/markets/crypto/tickers/*/book", ClassSnapshot},
+
+	{"IndexSnapshot", "/v3/snapshot/indices", ClassSnapshot},
+
+	{"OptionsContract", "/v3/reference/options/contracts/*", ClassReference},
+	{"OptionsChain", "/v3/snapshot/options/*", ClassSnapshot},
+	{"TradesV3", "/v3/trades/*", ClassTicks},
+	{"QuotesV3", "/v3/quotes/*", ClassTicks},
+
+	{"ForexHistoricTicks", "/v1/historic/forex/*/*/*", ClassTicks},
+	{"ForexConversion", "/v1/conversion/*/*", ClassLast},
+	{"ForexLastQuote", "/v1/last_quote/currencies/*/*", ClassLast},
+	{"ForexSnapshotAll", "/v2/snapshot/locale/global/markets/forex/tickers", ClassBulk},
+	{"ForexSnapshotGainersLosers", "/v2/snapshot/locale/global/markets/forex/*", ClassSnapshot},
+}
+
+func (r route) match(segments []string) bool {
+	pattern := strings.Split(strings.Trim(r.pattern, "/"), "/")
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupRoute finds the route of an endpoint or absolute URL. Unknown paths
+// map to a route of ClassOther named after the path.
+func lookupRoute(endpoint string) route {
+	path := endpoint
+	if u, err := url.Parse(endpoint); err == nil {
+		path = u.Path
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, r := range routes {
+		if r.match(segments) {
+			return r
+		}
+	}
+	return route{name: path, pattern: path, class: ClassOther}
+}
@@ -23,13 +23,21 @@ const (
 ////////////////////////////////////////////////////////////////////////////
 
 func (c *Client) StockExchanges() (Exchanges, error) {
+	return c.StockExchangesContext(context.Background())
+}
+
+func (c *Client) StockExchangesContext(ctx context.Context) (Exchanges, error) {
 	var out Exchanges
 	endpoint := fmt.Sprintf("/v1/meta/exchanges")
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
 func (c *Client) StockPreviousClose(ticker string, opts *RequestOptions) (*Bars, error) {
+	return c.StockPreviousCloseContext(context.Background(), ticker, opts)
+}
+
+func (c *Client) StockPreviousCloseContext(ctx context.Context, ticker string, opts *RequestOptions) (*Bars, error) {
 	out := struct {
 		Results Bars `json:"results"`
 	}{}
@@ -38,7 +46,7 @@ func (c *Client) StockPreviousClose(ticker string, opts *RequestOptions) (*Bars,
 	if err != nil {
 		return nil, err
 	}
-	err = c.GetJSON(context.Background(), endpoint, &out)
+	err = c.GetJSON(ctx, endpoint, &out)
 	if err != nil {
 		return nil, err
 	}
@@ -61,21 +69,70 @@ func (c *Client) endpointWithOpts(endpoint string, opts *RequestOptions) (string
 }
 
 func (c *Client) StockAggregates(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
-	var out StockBarsResponse
-	endpoint := fmt.Sprintf("/v2/aggs/ticker/%s/range/%s/%s/%s/%s", url.PathEscape(ticker), url.PathEscape(strconv.Itoa(int(multiplier))), url.PathEscape(string(timespan)), url.PathEscape(from.Format(DateLayoutISO)), url.PathEscape(to.Format(DateLayoutISO)))
-	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	return c.StockAggregatesContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+// StockAggregatesContext returns the bars between the from and to dates. Ranges
+// holding more bars than the limit of a single request are fetched in chunks,
+// see WithChunkConcurrency.
+func (c *Client) StockAggregatesContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	chunks, err := c.aggregatesRange(ctx, ticker, aggregatesSpan{from: from, to: to}, opts, c.stockAggregates(ticker, multiplier, timespan))
 	if err != nil {
 		return nil, err
 	}
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bars := mergeBars(chunks, opts != nil && opts.Sort == Desc)
+	return &bars, nil
+}
+
+// StockAggregatesMillis is StockAggregates with from and to passed as Unix
+// milliseconds instead of dates, e.g. to fetch part of a trading session or
+// resume after the last bar stored. Both bounds are inclusive.
+func (c *Client) StockAggregatesMillis(ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockAggregatesMillisContext(context.Background(), ticker, multiplier, timespan, from, to, opts)
+}
+
+func (c *Client) StockAggregatesMillisContext(ctx context.Context, ticker string, multiplier int32, timespan Timespan, from, to time.Time, opts *RequestOptions) (*Bars, error) {
+	chunks, err := c.aggregatesRange(ctx, ticker, aggregatesSpan{from: from, to: to, fromMillis: true, toMillis: true}, opts, c.stockAggregates(ticker, multiplier, timespan))
 	if err != nil {
 		return nil, err
 	}
-	err = ej.Unmarshal(bts, &out)
-	return &out.Results, err
+	bars := mergeBars(chunks, opts != nil && opts.Sort == Desc)
+	return &bars, nil
+}
+
+func (c *Client) stockAggregates(ticker string, multiplier int32, timespan Timespan) aggregatesFetch {
+	return func(ctx context.Context, span aggregatesSpan, opts *RequestOptions) (aggregatesChunk, error) {
+		var out StockBarsResponse
+		if err := c.aggregates(ctx, ticker, multiplier, timespan, span, opts, &out); err != nil {
+			return aggregatesChunk{}, err
+		}
+		times := make([]int64, len(out.Results))
+		for i, b := range out.Results {
+			times[i] = b.Time
+		}
+		return aggregatesChunk{bars: out.Results, times: times, queryCount: int(out.QueryCount)}, nil
+	}
+}
+
+func (c *Client) aggregates(ctx context.Context, ticker string, multiplier int32, timespan Timespan, span aggregatesSpan, opts *RequestOptions, out ej.Unmarshaler) error {
+	from, to := span.bounds()
+	endpoint := fmt.Sprintf("/v2/aggs/ticker/%s/range/%s/%s/%s/%s", url.PathEscape(ticker), url.PathEscape(strconv.Itoa(int(multiplier))), url.PathEscape(string(timespan)), url.PathEscape(from), url.PathEscape(to))
+	endpoint, err := c.endpointWithOpts(endpoint, opts)
+	if err != nil {
+		return err
+	}
+	bts, err := c.GetBytes(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	return ej.Unmarshal(bts, out)
 }
 
 func (c *Client) StockGroupedDaily(locale Locale, market Market, date time.Time, opts *RequestOptions) (*Bars, error) {
+	return c.StockGroupedDailyContext(context.Background(), locale, market, date, opts)
+}
+
+func (c *Client) StockGroupedDailyContext(ctx context.Context, locale Locale, market Market, date time.Time, opts *RequestOptions) (*Bars, error) {
 	layoutISO := "2006-01-02"
 	var out StockBarsResponse
 	endpoint := fmt.Sprintf("/v2/aggs/grouped/locale/%s/market/%s/%s", url.PathEscape(string(locale)), url.PathEscape(string(market)), url.PathEscape(date.Format(layoutISO)))
@@ -83,7 +140,7 @@ func (c *Client) StockGroupedDaily(locale Locale, market Market, date time.Time,
 	if err != nil {
 		return nil, err
 	}
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bts, err := c.GetBytes(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -92,13 +149,17 @@ func (c *Client) StockGroupedDaily(locale Locale, market Market, date time.Time,
 }
 
 func (c *Client) StockTrades(ticker string, date time.Time, opts *RequestOptions) (*Trades, error) {
+	return c.StockTradesContext(context.Background(), ticker, date, opts)
+}
+
+func (c *Client) StockTradesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) (*Trades, error) {
 	var out StockTradesResponse
 	endpoint := fmt.Sprintf("/v2/ticks/stocks/trades/%s/%s", url.PathEscape(ticker), url.PathEscape(date.Format(DateLayoutISO)))
 	endpoint, err := c.endpointWithOpts(endpoint, opts)
 	if err != nil {
 		return nil, err
 	}
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bts, err := c.GetBytes(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -107,33 +168,46 @@ func (c *Client) StockTrades(ticker string, date time.Time, opts *RequestOptions
 }
 
 func (c *Client) StockDailyTrades(ticker string, date time.Time, opts *RequestOptions) ([]*Trades, error) {
-	if opts == nil {
-		opts = &RequestOptions{Limit: 50000}
-	}
+	return c.StockDailyTradesContext(context.Background(), ticker, date, opts)
+}
+
+// StockDailyTradesContext returns all trades of ticker on date, one page per
+// request, see StockTradesIter. Prefer StockDailyTradesEach or StockTradesIter
+// for busy tickers, which do not hold the whole day in memory.
+func (c *Client) StockDailyTradesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) ([]*Trades, error) {
+	cursor := newTickCursor(opts)
 	var out []*Trades
-	for {
-		trades, err := c.StockTrades(ticker, date, opts)
+	for !cursor.done {
+		var page *Trades
+		start, err := cursor.next(ctx, func(ctx context.Context, opts *RequestOptions) (int, func(int) int64, error) {
+			var err error
+			if page, err = c.StockTradesContext(ctx, ticker, date, opts); err != nil {
+				return 0, nil, err
+			}
+			return len(*page), func(i int) int64 { return (*page)[i].SIPTime }, nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		if len(*trades) <= 1 {
-			out = append(out, trades)
-			break
+		if rest := (*page)[start:]; len(rest) > 0 || len(out) == 0 {
+			out = append(out, &rest)
 		}
-		out = append(out, trades)
-		opts.Timestamp = (*trades)[len(*trades)-1].SIPTime
 	}
 	return out, nil
 }
 
 func (c *Client) StockQuotes(ticker string, date time.Time, opts *RequestOptions) (*Quotes, error) {
+	return c.StockQuotesContext(context.Background(), ticker, date, opts)
+}
+
+func (c *Client) StockQuotesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) (*Quotes, error) {
 	var out StockQuotesResponse
 	endpoint := fmt.Sprintf("/v2/ticks/stocks/nbbo/%s/%s", url.PathEscape(ticker), url.PathEscape(date.Format(DateLayoutISO)))
 	endpoint, err := c.endpointWithOpts(endpoint, opts)
 	if err != nil {
 		return nil, err
 	}
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bts, err := c.GetBytes(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -142,61 +216,129 @@ func (c *Client) StockQuotes(ticker string, date time.Time, opts *RequestOptions
 }
 
 func (c *Client) StockDailyQuotes(ticker string, date time.Time, opts *RequestOptions) ([]*Quotes, error) {
-	if opts == nil {
-		opts = &RequestOptions{Limit: 50000}
-	}
+	return c.StockDailyQuotesContext(context.Background(), ticker, date, opts)
+}
+
+// StockDailyQuotesContext returns all quotes of ticker on date, one page per
+// request, see StockQuotesIter. Prefer StockDailyQuotesEach or StockQuotesIter
+// for busy tickers, which do not hold the whole day in memory.
+func (c *Client) StockDailyQuotesContext(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) ([]*Quotes, error) {
+	cursor := newTickCursor(opts)
 	var out []*Quotes
-	for {
-		quotes, err := c.StockQuotes(ticker, date, opts)
+	for !cursor.done {
+		var page *Quotes
+		start, err := cursor.next(ctx, func(ctx context.Context, opts *RequestOptions) (int, func(int) int64, error) {
+			var err error
+			if page, err = c.StockQuotesContext(ctx, ticker, date, opts); err != nil {
+				return 0, nil, err
+			}
+			return len(*page), func(i int) int64 { return (*page)[i].SIPTime }, nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		if len(*quotes) <= 1 {
-			out = append(out, quotes)
-			break
+		if rest := (*page)[start:]; len(rest) > 0 || len(out) == 0 {
+			out = append(out, &rest)
 		}
-		out = append(out, quotes)
-		opts.Timestamp = (*quotes)[len(*quotes)-1].SIPTime
 	}
 	return out, nil
 }
 
+// StockTradesV3 returns a page of trades of ticker from the v3 trades
+// endpoint, with the cursor of the next page, empty on the last one. Pass it
+// as opts.Cursor to fetch the next page, or use StockTradesV3Iter.
+func (c *Client) StockTradesV3(ticker string, opts *V3TickOptions) (V3Trades, string, error) {
+	return c.StockTradesV3Context(context.Background(), ticker, opts)
+}
+
+func (c *Client) StockTradesV3Context(ctx context.Context, ticker string, opts *V3TickOptions) (V3Trades, string, error) {
+	endpoint, err := c.v3TicksWithOpts(fmt.Sprintf("/v3/trades/%s", url.PathEscape(ticker)), opts)
+	if err != nil {
+		return nil, "", err
+	}
+	var out V3TradesResponse
+	cursor, err := c.v3Page(ctx, endpoint, &out)
+	if err != nil {
+		return nil, "", err
+	}
+	return out.Results, cursor, nil
+}
+
+// StockQuotesV3 returns a page of quotes of ticker from the v3 quotes
+// endpoint, see StockTradesV3.
+func (c *Client) StockQuotesV3(ticker string, opts *V3TickOptions) (V3Quotes, string, error) {
+	return c.StockQuotesV3Context(context.Background(), ticker, opts)
+}
+
+func (c *Client) StockQuotesV3Context(ctx context.Context, ticker string, opts *V3TickOptions) (V3Quotes, string, error) {
+	endpoint, err := c.v3TicksWithOpts(fmt.Sprintf("/v3/quotes/%s", url.PathEscape(ticker)), opts)
+	if err != nil {
+		return nil, "", err
+	}
+	var out V3QuotesResponse
+	cursor, err := c.v3Page(ctx, endpoint, &out)
+	if err != nil {
+		return nil, "", err
+	}
+	return out.Results, cursor, nil
+}
+
 func (c *Client) StockLastTrade(ticker string) (LastTrade, error) {
+	return c.StockLastTradeContext(context.Background(), ticker)
+}
+
+func (c *Client) StockLastTradeContext(ctx context.Context, ticker string) (LastTrade, error) {
 	out := struct {
 		Last LastTrade `json:"last"`
 	}{}
 	endpoint := fmt.Sprintf("/v1/last/stocks/%s", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Last, err
 }
 
 func (c *Client) StockLastQuote(ticker string) (LastQuote, error) {
+	return c.StockLastQuoteContext(context.Background(), ticker)
+}
+
+func (c *Client) StockLastQuoteContext(ctx context.Context, ticker string) (LastQuote, error) {
 	out := struct {
 		Last LastQuote `json:"last"`
 	}{}
 	endpoint := fmt.Sprintf("/v1/last_quote/stocks/%s", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out.Last, err
 }
 
 func (c *Client) StockDaily(ticker string, date time.Time) (*Daily, error) {
+	return c.StockDailyContext(context.Background(), ticker, date)
+}
+
+func (c *Client) StockDailyContext(ctx context.Context, ticker string, date time.Time) (*Daily, error) {
 	var out Daily
 	endpoint := fmt.Sprintf("/v1/open-close/%s/%s", url.PathEscape(ticker), url.PathEscape(date.Format(DateLayoutISO)))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return &out, err
 }
 
 func (c *Client) StockConditionMappings(tick Tick) (map[string]string, error) {
+	return c.StockConditionMappingsContext(context.Background(), tick)
+}
+
+func (c *Client) StockConditionMappingsContext(ctx context.Context, tick Tick) (map[string]string, error) {
 	out := make(map[string]string)
 	endpoint := fmt.Sprintf("/v1/meta/conditions/%s", url.PathEscape(string(tick)))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return out, err
 }
 
 func (c *Client) StockSnapshotAll() (*Snapshots, error) {
+	return c.StockSnapshotAllContext(context.Background())
+}
+
+func (c *Client) StockSnapshotAllContext(ctx context.Context) (*Snapshots, error) {
 	var out StockSnapshotsResponse
 	endpoint := fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/tickers")
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bts, err := c.GetBytes(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -205,18 +347,26 @@ func (c *Client) StockSnapshotAll() (*Snapshots, error) {
 
 }
 func (c *Client) StockSnapshotSingle(ticker string) (*Snapshot, error) {
+	return c.StockSnapshotSingleContext(context.Background(), ticker)
+}
+
+func (c *Client) StockSnapshotSingleContext(ctx context.Context, ticker string) (*Snapshot, error) {
 	out := struct {
 		Ticker Snapshot `json:"ticker"`
 	}{}
 	endpoint := fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/tickers/%s", url.PathEscape(ticker))
-	err := c.GetJSON(context.Background(), endpoint, &out)
+	err := c.GetJSON(ctx, endpoint, &out)
 	return &out.Ticker, err
 
 }
 func (c *Client) StockSnapshotTopGainersLosers(direction Direction) (*Snapshots, error) {
+	return c.StockSnapshotTopGainersLosersContext(context.Background(), direction)
+}
+
+func (c *Client) StockSnapshotTopGainersLosersContext(ctx context.Context, direction Direction) (*Snapshots, error) {
 	var out StockSnapshotsResponse
 	endpoint := fmt.Sprintf("/v2/snapshot/locale/us/markets/stocks/%s", url.PathEscape(string(direction)))
-	bts, err := c.GetBytes(context.Background(), endpoint)
+	bts, err := c.GetBytes(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -40,15 +40,7 @@ type Stream struct {
 
 func GetStream(apiKey, streamEndpoint string) (*Stream, error) {
 	once.Do(func() {
-		stream = &Stream{
-			authenticated: atomic.Value{},
-			MessageC:      make(chan []byte, 100),
-			ErrorC:        make(chan error, 100),
-			credentials: credentials{apiKey: apiKey,
-				streamEndpoint: streamEndpoint},
-		}
-		stream.authenticated.Store(false)
-		stream.closed.Store(false)
+		stream = newStream(apiKey, streamEndpoint)
 	})
 	err := stream.Register()
 	if err != nil {
@@ -57,6 +49,29 @@ func GetStream(apiKey, streamEndpoint string) (*Stream, error) {
 	return stream, nil
 }
 
+// NewStream opens a Stream that is independent of the one shared through
+// GetStream, e.g. to connect to several clusters or to a test server.
+func NewStream(apiKey, streamEndpoint string) (*Stream, error) {
+	s := newStream(apiKey, streamEndpoint)
+	if err := s.Register(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newStream(apiKey, streamEndpoint string) *Stream {
+	s := &Stream{
+		authenticated: atomic.Value{},
+		MessageC:      make(chan []byte, 100),
+		ErrorC:        make(chan error, 100),
+		credentials: credentials{apiKey: apiKey,
+			streamEndpoint: streamEndpoint},
+	}
+	s.authenticated.Store(false)
+	s.closed.Store(false)
+	return s
+}
+
 func (s *Stream) Register() error {
 	var err error
 	if s.conn == nil {
@@ -97,24 +112,32 @@ func (s *Stream) Unsubscribe(channel string) error {
 	return nil
 }
 
+// Close closes the connection and the MessageC and ErrorC channels. The
+// channels are closed even when the close frame cannot be sent, e.g. when
+// the server is already gone, and that error is returned.
 func (s *Stream) Close() error {
 	s.Lock()
 	defer s.Unlock()
 
-	if s.conn == nil {
+	if closed, _ := s.closed.Load().(bool); closed {
 		return nil
 	}
-
-	if err := s.conn.WriteMessage(
-		websocket.CloseMessage,
-		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
-	); err != nil {
-		return err
-	}
+	// mark the stream closed first, so the reader does not try to reconnect
+	// when the server acknowledges the close frame
 	s.closed.Store(true)
+	var err error
+	if s.conn != nil {
+		err = s.conn.WriteMessage(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		)
+		if cerr := s.conn.Close(); err == nil {
+			err = cerr
+		}
+	}
 	close(s.MessageC)
 	close(s.ErrorC)
-	return s.conn.Close()
+	return err
 }
 
 func (s *Stream) openSocket() (*websocket.Conn, error) {
@@ -177,22 +200,22 @@ func (s *Stream) auth() error {
 
 func (s *Stream) start() {
 	for {
-		code, bts, err := s.conn.ReadMessage()
+		_, bts, err := s.conn.ReadMessage()
 		if err != nil {
 			if s.closed.Load().(bool) {
 				return
-			} else if websocket.IsCloseError(err, code) {
+			} else if _, ok := err.(*websocket.CloseError); ok {
 				err := s.reconnect()
 				if err != nil {
 					s.ErrorC <- err
-					s.conn = nil
+					s.setConn(nil)
 					fmt.Println(fmt.Sprintf("unknown error %+v", err))
 					return
 				}
 				continue
 			} else {
 				s.ErrorC <- err
-				s.conn = nil
+				s.setConn(nil)
 				fmt.Println(fmt.Sprintf("unknown error %+v", err))
 				return
 			}
@@ -229,10 +252,19 @@ func (s *Stream) unsub(channel string) error {
 	return err
 }
 
+// setConn swaps the connection under the lock, as Close and the
+// subscription methods may use it from other goroutines.
+func (s *Stream) setConn(conn *websocket.Conn) {
+	s.Lock()
+	defer s.Unlock()
+	s.conn = conn
+}
+
 func (s *Stream) reconnect() error {
-	var err error
 	s.authenticated.Store(false)
-	if s.conn, err = s.openSocket(); err != nil {
+	conn, err := s.openSocket()
+	s.setConn(conn)
+	if err != nil {
 		return err
 	}
 	if err = s.auth(); err != nil {
@@ -288,3 +320,27 @@ func ParseStreamAggregates(bts []byte, isEJ ...bool) (StreamAggregates, error) {
 	}
 	return out, err
 }
+
+func ParseStreamIndexValues(bts []byte, isEJ ...bool) (StreamIndexValues, error) {
+	iEJ := true
+	var out StreamIndexValues
+	var err error
+	if iEJ {
+		err = ej.Unmarshal(bts, &out)
+	} else {
+		err = json.Unmarshal(bts, &out)
+	}
+	return out, err
+}
+
+func ParseStreamIndexAggregates(bts []byte, isEJ ...bool) (StreamIndexAggregates, error) {
+	iEJ := true
+	var out StreamIndexAggregates
+	var err error
+	if iEJ {
+		err = ej.Unmarshal(bts, &out)
+	} else {
+		err = json.Unmarshal(bts, &out)
+	}
+	return out, err
+}
@@ -0,0 +1,41 @@
+package polygonio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestStreamCloseAfterPeerGone(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newStream("KEY", "")
+	s.setConn(conn)
+	conn.UnderlyingConn().Close()
+
+	if err := s.Close(); err == nil {
+		t.Fatal("expected the failed close frame to be reported")
+	}
+	if _, ok := <-s.MessageC; ok {
+		t.Fatal("expected MessageC to be closed")
+	}
+	if _, ok := <-s.ErrorC; ok {
+		t.Fatal("expected ErrorC to be closed")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("expected a second Close to be a no-op, got %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxTicksLimit is the largest page of the v2 ticks endpoints.
+const maxTicksLimit = 50000
+
+// tickCursor pages through the ticks of a day with the timestamp offset of
+// the legacy v2 ticks endpoints. The offset is inclusive, so each page starts
+// with the ticks at the last SIP timestamp of the previous page; the cursor
+// remembers how many of those were already seen and skips them.
+type tickCursor struct {
+	opts   RequestOptions
+	limit  int
+	seen   int // ticks at opts.Timestamp returned so far
+	done   bool
+	offset bool // opts.Timestamp is the last timestamp of a page, not the caller's
+}
+
+// newTickCursor copies opts, the caller's options are never modified. Pages
+// are always read in ascending order, Reverse is ignored.
+func newTickCursor(opts *RequestOptions) *tickCursor {
+	c := &tickCursor{}
+	if opts != nil {
+		c.opts = *opts
+	}
+	c.opts.Reverse = ""
+	if c.opts.Limit <= 0 || c.opts.Limit > maxTicksLimit {
+		c.opts.Limit = maxTicksLimit
+	}
+	c.limit = int(c.opts.Limit)
+	return c
+}
+
+// next fetches the next page and returns the index of its first tick not
+// returned before. fetch returns the number of ticks of the page and their
+// SIP timestamps.
+func (c *tickCursor) next(ctx context.Context, fetch func(ctx context.Context, opts *RequestOptions) (int, func(int) int64, error)) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	opts := c.opts
+	n, sipTime, err := fetch(ctx, &opts)
+	if err != nil {
+		return 0, err
+	}
+	start := 0
+	if c.offset {
+		for start < n && start < c.seen && sipTime(start) == c.opts.Timestamp {
+			start++
+		}
+	}
+	if n < c.limit {
+		c.done = true
+		return start, nil
+	}
+
+	last := sipTime(n - 1)
+	if c.offset && last == c.opts.Timestamp {
+		// a whole page at a single timestamp, the offset cannot move
+		return 0, fmt.Errorf("polygon: tick cursor did not advance past %d", last)
+	}
+	c.seen = 0
+	for i := n - 1; i >= 0 && sipTime(i) == last; i-- {
+		c.seen++
+	}
+	c.opts.Timestamp = last
+	c.offset = true
+	return start, nil
+}
+
+// TradeIterator iterates over the trades of a day in SIP timestamp order.
+type TradeIterator struct {
+	ctx    context.Context
+	cursor *tickCursor
+	fetch  func(ctx context.Context, opts *RequestOptions) (*Trades, error)
+	buf    Trades
+	cur    Trade
+	err    error
+}
+
+// Next advances to the next trade, fetching pages as needed. It returns false
+// when the day has been read or an error occurred, see Err.
+func (it *TradeIterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.err != nil || it.cursor.done {
+			return false
+		}
+		var page *Trades
+		start, err := it.cursor.next(it.ctx, func(ctx context.Context, opts *RequestOptions) (int, func(int) int64, error) {
+			var err error
+			if page, err = it.fetch(ctx, opts); err != nil {
+				return 0, nil, err
+			}
+			return len(*page), func(i int) int64 { return (*page)[i].SIPTime }, nil
+		})
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.buf = (*page)[start:]
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Value returns the current trade.
+func (it *TradeIterator) Value() Trade {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TradeIterator) Err() error {
+	return it.err
+}
+
+// StockTradesIter returns an iterator over all trades of ticker on date,
+// starting at opts.Timestamp and ending before opts.TimestampLimit if set.
+// opts.Limit is the page size, 50000 by default and at most. opts is not
+// modified.
+func (c *Client) StockTradesIter(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) *TradeIterator {
+	return &TradeIterator{
+		ctx:    ctx,
+		cursor: newTickCursor(opts),
+		fetch: func(ctx context.Context, opts *RequestOptions) (*Trades, error) {
+			return c.StockTradesContext(ctx, ticker, date, opts)
+		},
+	}
+}
+
+// QuoteIterator iterates over the quotes of a day in SIP timestamp order.
+type QuoteIterator struct {
+	ctx    context.Context
+	cursor *tickCursor
+	fetch  func(ctx context.Context, opts *RequestOptions) (*Quotes, error)
+	buf    Quotes
+	cur    Quote
+	err    error
+}
+
+// Next advances to the next quote, fetching pages as needed. It returns false
+// when the day has been read or an error occurred, see Err.
+func (it *QuoteIterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.err != nil || it.cursor.done {
+			return false
+		}
+		var page *Quotes
+		start, err := it.cursor.next(it.ctx, func(ctx context.Context, opts *RequestOptions) (int, func(int) int64, error) {
+			var err error
+			if page, err = it.fetch(ctx, opts); err != nil {
+				return 0, nil, err
+			}
+			return len(*page), func(i int) int64 { return (*page)[i].SIPTime }, nil
+		})
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.buf = (*page)[start:]
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Value returns the current quote.
+func (it *QuoteIterator) Value() Quote {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *QuoteIterator) Err() error {
+	return it.err
+}
+
+// StockQuotesIter returns an iterator over all quotes of ticker on date, see
+// StockTradesIter.
+func (c *Client) StockQuotesIter(ctx context.Context, ticker string, date time.Time, opts *RequestOptions) *QuoteIterator {
+	return &QuoteIterator{
+		ctx:    ctx,
+		cursor: newTickCursor(opts),
+		fetch: func(ctx context.Context, opts *RequestOptions) (*Quotes, error) {
+			return c.StockQuotesContext(ctx, ticker, date, opts)
+		},
+	}
+}
+
+// StockTradesV3Iter returns an iterator over the trades of ticker from the v3
+// trades endpoint, following the cursor of each page. opts is not modified.
+func (c *Client) StockTradesV3Iter(ctx context.Context, ticker string, opts *V3TickOptions) *V3TradeIterator {
+	return c.v3TradesIter(ctx, ticker, opts)
+}
+
+// StockQuotesV3Iter returns an iterator over the quotes of ticker from the v3
+// quotes endpoint, see StockTradesV3Iter.
+func (c *Client) StockQuotesV3Iter(ctx context.Context, ticker string, opts *V3TickOptions) *V3QuoteIterator {
+	return c.v3QuotesIter(ctx, ticker, opts)
+}
+
+// StockDailyTradesEach calls fn for every trade of ticker on date, see
+// StockTradesIter. It stops at the first error returned by fn.
+func (c *Client) StockDailyTradesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Trade) error) error {
+	it := c.StockTradesIter(ctx, ticker, date, opts)
+	for it.Next() {
+		if err := fn(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// StockDailyQuotesEach calls fn for every quote of ticker on date, see
+// StockTradesIter. It stops at the first error returned by fn.
+func (c *Client) StockDailyQuotesEach(ctx context.Context, ticker string, date time.Time, opts *RequestOptions, fn func(Quote) error) error {
+	it := c.StockQuotesIter(ctx, ticker, date, opts)
+	for it.Next() {
+		if err := fn(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
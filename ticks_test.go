@@ -0,0 +1,92 @@
+package polygonio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// tickPages serves trades and quotes at the given SIP timestamps like the v2
+// ticks endpoints: from the inclusive timestamp offset, up to limit of them.
+func tickPages(times []int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		offset, _ := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		var results []map[string]int64
+		for i, t := range times {
+			if t >= offset && len(results) < limit {
+				results = append(results, map[string]int64{"t": t, "q": int64(i)})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+	})
+}
+
+func TestStockTradesIter(t *testing.T) {
+	// several trades share a timestamp across page boundaries
+	times := []int64{1, 2, 2, 3, 3, 3, 4, 5, 5, 6}
+	srv := httptest.NewServer(tickPages(times))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	opts := &RequestOptions{Limit: 4}
+	it := client.StockTradesIter(context.Background(), "AAPL", date("2021-01-04"), opts)
+	var seq []int32
+	for it.Next() {
+		seq = append(seq, it.Value().Sequence)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(seq) != len(times) {
+		t.Fatalf("expected %d trades, got %v", len(times), seq)
+	}
+	for i, s := range seq {
+		if s != int32(i) {
+			t.Fatalf("expected trades in order without duplicates, got %v", seq)
+		}
+	}
+	if *opts != (RequestOptions{Limit: 4}) {
+		t.Fatalf("options were modified: %+v", *opts)
+	}
+
+	pages, err := client.StockDailyQuotes("AAPL", date("2021-01-04"), &RequestOptions{Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, page := range pages {
+		n += len(*page)
+	}
+	if n != len(times) {
+		t.Fatalf("expected %d quotes, got %d", len(times), n)
+	}
+}
+
+func TestStockTradesIterStuckCursor(t *testing.T) {
+	srv := httptest.NewServer(tickPages([]int64{1, 2, 2, 2, 3}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	stop := errors.New("stop")
+	calls := 0
+	err := client.StockDailyTradesEach(context.Background(), "AAPL", date("2021-01-04"), &RequestOptions{Limit: 2}, func(Trade) error {
+		calls++
+		return nil
+	})
+	if err == nil || calls != 2 {
+		t.Fatalf("expected the cursor to be stuck after 2 trades, got %d and %v", calls, err)
+	}
+
+	err = client.StockDailyTradesEach(context.Background(), "AAPL", date("2021-01-04"), nil, func(Trade) error {
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("expected the callback error, got %v", err)
+	}
+}
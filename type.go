@@ -36,7 +36,9 @@ type Bars []Bar
 
 //easyjson:json
 type StockBarsResponse struct {
-	Results Bars `json:"results"`
+	QueryCount   int32 `json:"queryCount"`
+	ResultsCount int32 `json:"resultsCount"`
+	Results      Bars  `json:"results"`
 }
 
 type Trade struct {
@@ -275,8 +277,9 @@ const (
 	Crypto  Market = "crypto"
 	Bond    Market = "mf"
 	MMF     Market = "mmf"
-	Indices Market = "indicies"
+	Indices Market = "indices"
 	FX      Market = "fx"
+	Options Market = "options"
 )
 
 type Locale string
@@ -555,6 +558,72 @@ type CryptoTrade struct {
 }
 
 type CryptoTrades []CryptoTrade
+
+type CryptoLastTrade struct {
+	Price      float64 `json:"price"`
+	Size       float64 `json:"size"`
+	Exchange   int32   `json:"exchange"`
+	Conditions []int32 `json:"conditions"`
+	Timestamp  int64   `json:"timestamp"` // Unix milliseconds
+}
+
+type CryptoTradesOptions struct {
+	Offset int64 `url:"offset,omitempty"` // timestamp in Unix milliseconds to start from
+	Limit  int64 `url:"limit,omitempty"`
+}
+
+type CryptoHistoricTradesResponse struct {
+	Day    string       `json:"day"`
+	Symbol string       `json:"symbol"`
+	Ticks  CryptoTrades `json:"ticks"`
+}
+
+type CryptoSnapshot struct {
+	Ticker         string      `json:"ticker"`
+	TodayChange    float32     `json:"todaysChange"`
+	TodayChangePct float32     `json:"todaysChangePerc"`
+	Day            Bar         `json:"day"`
+	PrevDay        Bar         `json:"prevDay"`
+	LastTrade      CryptoTrade `json:"lastTrade"`
+	Min            Bar         `json:"min"`
+	Updated        int64       `json:"updated"`
+}
+
+type CryptoSnapshots []CryptoSnapshot
+
+//easyjson:json
+type CryptoSnapshotsResponse struct {
+	Results CryptoSnapshots `json:"tickers"`
+}
+
+// BookLevel is a price level of an order book with the size offered at that
+// price on each exchange, keyed by exchange id.
+type BookLevel struct {
+	Price float64            `json:"p"`
+	Sizes map[string]float64 `json:"x"`
+}
+
+// Size returns the size offered at the level across all exchanges.
+func (l BookLevel) Size() float64 {
+	var size float64
+	for _, s := range l.Sizes {
+		size += s
+	}
+	return size
+}
+
+// CryptoBook is the consolidated level 2 book of a crypto ticker, bids from
+// the highest price down and asks from the lowest price up.
+type CryptoBook struct {
+	Ticker   string      `json:"ticker"`
+	Bids     []BookLevel `json:"bids"`
+	Asks     []BookLevel `json:"asks"`
+	BidCount float64     `json:"bidCount"`
+	AskCount float64     `json:"askCount"`
+	Spread   float64     `json:"spread"`
+	Updated  int64       `json:"updated"` // Unix nanoseconds
+}
+
 type CryptoDaily struct {
 	Ticker        string       `json:"symbol"`
 	IsUTC         bool         `json:"isUTC"`
@@ -565,6 +634,265 @@ type CryptoDaily struct {
 	ClosingTrades CryptoTrades `json:"closingTrades"`
 }
 
+type ForexTicksOptions struct {
+	Offset int64 `url:"offset,omitempty"` // timestamp in Unix milliseconds to start from
+	Limit  int64 `url:"limit,omitempty"`
+}
+
+// ForexTick is a historic forex quote.
+type ForexTick struct {
+	Ask      float64 `json:"a"`
+	Bid      float64 `json:"b"`
+	Exchange int32   `json:"x"`
+	Time     int64   `json:"t"` // Unix milliseconds
+}
+
+type ForexTicks []ForexTick
+
+type ForexHistoricTicksResponse struct {
+	Day   string     `json:"day"`
+	Pair  string     `json:"pair"`
+	Ticks ForexTicks `json:"ticks"`
+}
+
+type ForexQuote struct {
+	Ask       float64 `json:"ask"`
+	Bid       float64 `json:"bid"`
+	Exchange  int32   `json:"exchange"`
+	Timestamp int64   `json:"timestamp"` // Unix milliseconds
+}
+
+type ConversionOptions struct {
+	Amount    float64 `url:"amount,omitempty"`
+	Precision int32   `url:"precision,omitempty"`
+}
+
+type ForexConversion struct {
+	From          string     `json:"from"`
+	To            string     `json:"to"`
+	InitialAmount float64    `json:"initialAmount"`
+	Converted     float64    `json:"converted"`
+	Last          ForexQuote `json:"last"`
+}
+
+type ForexSnapshot struct {
+	Ticker         string    `json:"ticker"`
+	TodayChange    float32   `json:"todaysChange"`
+	TodayChangePct float32   `json:"todaysChangePerc"`
+	Day            Bar       `json:"day"`
+	PrevDay        Bar       `json:"prevDay"`
+	LastQuote      ForexTick `json:"lastQuote"`
+	Min            Bar       `json:"min"`
+	Updated        int64     `json:"updated"`
+}
+
+type ForexSnapshots []ForexSnapshot
+
+//easyjson:json
+type ForexSnapshotsResponse struct {
+	Results ForexSnapshots `json:"tickers"`
+}
+
+// V3TickOptions are the parameters of the cursor paginated v3 trades and
+// quotes endpoints. Timestamps are dates (YYYY-MM-DD) or Unix nanoseconds,
+// Timestamp selects a day or an exact time, the others a range. Cursor is
+// the cursor of the next page returned by the previous call, it replaces all
+// other parameters.
+type V3TickOptions struct {
+	Timestamp    string `url:"timestamp,omitempty"`
+	TimestampGTE string `url:"timestamp.gte,omitempty"`
+	TimestampGT  string `url:"timestamp.gt,omitempty"`
+	TimestampLTE string `url:"timestamp.lte,omitempty"`
+	TimestampLT  string `url:"timestamp.lt,omitempty"`
+	Order        Sort   `url:"order,omitempty"`
+	Limit        int32  `url:"limit,omitempty"` // page size, 50000 at most
+	Sort         string `url:"sort,omitempty"`  // field to sort by, timestamp by default
+	Cursor       string `url:"cursor,omitempty"`
+}
+
+// V3Trade is a trade of the v3 trades endpoint. Trade is not reused as its
+// int32 size and sequence number would truncate fractional share sizes and
+// sequence numbers past 2^31, and the v3 field names differ.
+type V3Trade struct {
+	ID                   string  `json:"id"`
+	Exchange             int32   `json:"exchange"`
+	Price                float64 `json:"price"`
+	Size                 float64 `json:"size"`
+	Conditions           []int32 `json:"conditions"`
+	Correction           int32   `json:"correction"`
+	SequenceNumber       int64   `json:"sequence_number"`
+	SIPTimestamp         int64   `json:"sip_timestamp"`         // Unix nanoseconds
+	ParticipantTimestamp int64   `json:"participant_timestamp"` // Unix nanoseconds
+	TRFTimestamp         int64   `json:"trf_timestamp"`         // Unix nanoseconds
+	TRFID                int32   `json:"trf_id"`
+	Tape                 int32   `json:"tape"`
+}
+
+type V3Trades []V3Trade
+
+//easyjson:json
+type V3TradesResponse struct {
+	Results V3Trades `json:"results"`
+	NextURL string   `json:"next_url"`
+}
+
+// V3Quote is a quote of the v3 quotes endpoint. Quote is not reused for the
+// same reasons as Trade, see V3Trade.
+type V3Quote struct {
+	BidExchange          int32   `json:"bid_exchange"`
+	BidPrice             float64 `json:"bid_price"`
+	BidSize              float64 `json:"bid_size"`
+	AskExchange          int32   `json:"ask_exchange"`
+	AskPrice             float64 `json:"ask_price"`
+	AskSize              float64 `json:"ask_size"`
+	Conditions           []int32 `json:"conditions"`
+	Indicators           []int32 `json:"indicators"`
+	SequenceNumber       int64   `json:"sequence_number"`
+	SIPTimestamp         int64   `json:"sip_timestamp"`         // Unix nanoseconds
+	ParticipantTimestamp int64   `json:"participant_timestamp"` // Unix nanoseconds
+	TRFTimestamp         int64   `json:"trf_timestamp"`         // Unix nanoseconds
+	Tape                 int32   `json:"tape"`
+}
+
+type V3Quotes []V3Quote
+
+//easyjson:json
+type V3QuotesResponse struct {
+	Results V3Quotes `json:"results"`
+	NextURL string   `json:"next_url"`
+}
+
+type OptionType string
+
+const (
+	Call OptionType = "call"
+	Put  OptionType = "put"
+)
+
+type OptionContract struct {
+	Ticker            string     `json:"ticker"`
+	UnderlyingTicker  string     `json:"underlying_ticker"`
+	ContractType      OptionType `json:"contract_type"`
+	ExerciseStyle     string     `json:"exercise_style"`
+	ExpirationDate    string     `json:"expiration_date"`
+	StrikePrice       float64    `json:"strike_price"`
+	SharesPerContract float64    `json:"shares_per_contract"`
+	PrimaryExchange   string     `json:"primary_exchange"`
+	CFI               string     `json:"cfi"`
+}
+
+type OptionsChainOptions struct {
+	ContractType   OptionType `url:"contract_type,omitempty"`
+	ExpirationDate string     `url:"expiration_date,omitempty"` // YYYY-MM-DD
+	StrikePrice    float64    `url:"strike_price,omitempty"`
+	Order          Sort       `url:"order,omitempty"`
+	Limit          int32      `url:"limit,omitempty"` // page size, 250 at most
+	Sort           string     `url:"sort,omitempty"`
+}
+
+type OptionDay struct {
+	Open          float64 `json:"open"`
+	High          float64 `json:"high"`
+	Low           float64 `json:"low"`
+	Close         float64 `json:"close"`
+	Volume        float64 `json:"volume"`
+	VWAP          float64 `json:"vwap"`
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"change_percent"`
+	PreviousClose float64 `json:"previous_close"`
+	LastUpdated   int64   `json:"last_updated"` // Unix nanoseconds
+}
+
+type OptionGreeks struct {
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Theta float64 `json:"theta"`
+	Vega  float64 `json:"vega"`
+}
+
+type OptionLastQuote struct {
+	Bid         float64 `json:"bid"`
+	BidSize     float64 `json:"bid_size"`
+	Ask         float64 `json:"ask"`
+	AskSize     float64 `json:"ask_size"`
+	Midpoint    float64 `json:"midpoint"`
+	LastUpdated int64   `json:"last_updated"` // Unix nanoseconds
+}
+
+type UnderlyingAsset struct {
+	Ticker            string  `json:"ticker"`
+	Price             float64 `json:"price"`
+	ChangeToBreakEven float64 `json:"change_to_break_even"`
+	LastUpdated       int64   `json:"last_updated"` // Unix nanoseconds
+}
+
+// OptionSnapshot is the state of a contract in an options chain. Details
+// only holds the fields of the contract that identify it.
+type OptionSnapshot struct {
+	Details           OptionContract  `json:"details"`
+	Day               OptionDay       `json:"day"`
+	LastQuote         OptionLastQuote `json:"last_quote"`
+	Greeks            OptionGreeks    `json:"greeks"`
+	ImpliedVolatility float64         `json:"implied_volatility"`
+	OpenInterest      float64         `json:"open_interest"`
+	BreakEvenPrice    float64         `json:"break_even_price"`
+	UnderlyingAsset   UnderlyingAsset `json:"underlying_asset"`
+}
+
+type OptionSnapshots []OptionSnapshot
+
+// IndexBar is an aggregate of the value of an index. Indices are not traded,
+// so unlike Bar it has no volume, VWAP or trade count.
+type IndexBar struct {
+	Ticker string  `json:"T"`
+	Time   int64   `json:"t"`
+	Open   float64 `json:"o"`
+	Close  float64 `json:"c"`
+	High   float64 `json:"h"`
+	Low    float64 `json:"l"`
+}
+
+//easyjson:json
+type IndexBars []IndexBar
+
+//easyjson:json
+type IndexBarsResponse struct {
+	QueryCount int32     `json:"queryCount"`
+	Results    IndexBars `json:"results"`
+}
+
+type IndexSession struct {
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"change_percent"`
+	Open          float64 `json:"open"`
+	High          float64 `json:"high"`
+	Low           float64 `json:"low"`
+	Close         float64 `json:"close"`
+	PreviousClose float64 `json:"previous_close"`
+}
+
+// IndexSnapshot is the latest value of an index. Error and Message are set
+// instead when the ticker is unknown or not covered by the plan.
+type IndexSnapshot struct {
+	Ticker       string       `json:"ticker"`
+	Name         string       `json:"name"`
+	Value        float64      `json:"value"`
+	Type         string       `json:"type"`
+	MarketStatus string       `json:"market_status"`
+	Session      IndexSession `json:"session"`
+	LastUpdated  int64        `json:"last_updated"` // Unix nanoseconds
+	Timeframe    string       `json:"timeframe"`
+	Error        string       `json:"error"`
+	Message      string       `json:"message"`
+}
+
+type IndexSnapshots []IndexSnapshot
+
+//easyjson:json
+type IndexSnapshotsResponse struct {
+	Results IndexSnapshots `json:"results"`
+}
+
 // PolygonClientMsg is the standard message sent by clients of the stream interface
 type PolygonClientMsg struct {
 	Action string `json:"action"`
@@ -602,6 +930,8 @@ type StreamingServerMsg struct {
 	LowPrice          float32     `json:"l"`
 	Average           float32     `json:"a"`
 	EndTimestamp      int64       `json:"e"`
+	Ticker            string      `json:"T"`
+	Value             float64     `json:"val"`
 }
 
 //easyjson:json
@@ -664,3 +994,33 @@ type StreamAggregate struct {
 
 //easyjson:json
 type StreamAggregates []StreamAggregate
+
+// StreamIndexValue is the value of an index that polygon transmits on the
+// V.I:* channels.
+type StreamIndexValue struct {
+	Event     string  `json:"ev"`
+	Ticker    string  `json:"T"`
+	Value     float64 `json:"val"`
+	Timestamp int64   `json:"t"`
+}
+
+//easyjson:json
+type StreamIndexValues []StreamIndexValue
+
+// StreamIndexAggregate is the minute aggregate of an index that polygon
+// transmits on the AM.I:* channels. Indices are not traded, so it has no
+// volume.
+type StreamIndexAggregate struct {
+	Event          string  `json:"ev"`
+	Symbol         string  `json:"sym"`
+	OpeningPrice   float64 `json:"op"`
+	OpenPrice      float64 `json:"o"`
+	ClosePrice     float64 `json:"c"`
+	HighPrice      float64 `json:"h"`
+	LowPrice       float64 `json:"l"`
+	StartTimestamp int64   `json:"s"`
+	EndTimestamp   int64   `json:"e"`
+}
+
+//easyjson:json
+type StreamIndexAggregates []StreamIndexAggregate
@@ -17,7 +17,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(in *jlexer.Lexer, out *Trade) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(in *jlexer.Lexer, out *V3TradesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -36,25 +36,120 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(in *jlexer.Lexer, out *Tr
 			continue
 		}
 		switch key {
-		case "I":
-			out.ID = int64(in.Int64())
-		case "x":
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(V3Trades, 0, 0)
+					} else {
+						out.Results = V3Trades{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 V3Trade
+					(v1).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_url":
+			out.NextURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(out *jwriter.Writer, in V3TradesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Results {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next_url\":"
+		out.RawString(prefix)
+		out.String(string(in.NextURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v V3TradesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v V3TradesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *V3TradesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *V3TradesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(in *jlexer.Lexer, out *V3Trade) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "exchange":
 			out.Exchange = int32(in.Int32())
-		case "p":
+		case "price":
 			out.Price = float64(in.Float64())
-		case "i":
-			out.TradeID = string(in.String())
-		case "e":
-			out.CorrID = int32(in.Int32())
-		case "r":
-			out.ReportID = int32(in.Int32())
-		case "y":
-			out.ExTime = int64(in.Int64())
-		case "t":
-			out.SIPTime = int64(in.Int64())
-		case "f":
-			out.TRFTime = int64(in.Int64())
-		case "c":
+		case "size":
+			out.Size = float64(in.Float64())
+		case "conditions":
 			if in.IsNull() {
 				in.Skip()
 				out.Conditions = nil
@@ -70,19 +165,27 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(in *jlexer.Lexer, out *Tr
 					out.Conditions = (out.Conditions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 int32
-					v1 = int32(in.Int32())
-					out.Conditions = append(out.Conditions, v1)
+					var v4 int32
+					v4 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "q":
-			out.Sequence = int32(in.Int32())
-		case "s":
-			out.Size = int32(in.Int32())
-		case "z":
-			out.ListedEx = int32(in.Int32())
+		case "correction":
+			out.Correction = int32(in.Int32())
+		case "sequence_number":
+			out.SequenceNumber = int64(in.Int64())
+		case "sip_timestamp":
+			out.SIPTimestamp = int64(in.Int64())
+		case "participant_timestamp":
+			out.ParticipantTimestamp = int64(in.Int64())
+		case "trf_timestamp":
+			out.TRFTimestamp = int64(in.Int64())
+		case "trf_id":
+			out.TRFID = int32(in.Int32())
+		case "tape":
+			out.Tape = int32(in.Int32())
 		default:
 			in.SkipRecursive()
 		}
@@ -93,113 +196,108 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(in *jlexer.Lexer, out *Tr
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(out *jwriter.Writer, in Trade) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(out *jwriter.Writer, in V3Trade) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"I\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"x\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
 		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"p\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix)
 		out.Float64(float64(in.Price))
 	}
 	{
-		const prefix string = ",\"i\":"
-		out.RawString(prefix)
-		out.String(string(in.TradeID))
-	}
-	{
-		const prefix string = ",\"e\":"
+		const prefix string = ",\"size\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.CorrID))
+		out.Float64(float64(in.Size))
 	}
 	{
-		const prefix string = ",\"r\":"
+		const prefix string = ",\"conditions\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ReportID))
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Conditions {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v6))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"y\":"
+		const prefix string = ",\"correction\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.ExTime))
+		out.Int32(int32(in.Correction))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"sequence_number\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.SIPTime))
+		out.Int64(int64(in.SequenceNumber))
 	}
 	{
-		const prefix string = ",\"f\":"
+		const prefix string = ",\"sip_timestamp\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.TRFTime))
+		out.Int64(int64(in.SIPTimestamp))
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"participant_timestamp\":"
 		out.RawString(prefix)
-		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Conditions {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				out.Int32(int32(v3))
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.ParticipantTimestamp))
 	}
 	{
-		const prefix string = ",\"q\":"
+		const prefix string = ",\"trf_timestamp\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Sequence))
+		out.Int64(int64(in.TRFTimestamp))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"trf_id\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Size))
+		out.Int32(int32(in.TRFID))
 	}
 	{
-		const prefix string = ",\"z\":"
+		const prefix string = ",\"tape\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ListedEx))
+		out.Int32(int32(in.Tape))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Trade) MarshalJSON() ([]byte, error) {
+func (v V3Trade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient(w, v)
+func (v V3Trade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Trade) UnmarshalJSON(data []byte) error {
+func (v *V3Trade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient(l, v)
+func (v *V3Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(in *jlexer.Lexer, out *TickerOptions) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(in *jlexer.Lexer, out *V3TickOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -218,22 +316,24 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
+		case "Timestamp":
+			out.Timestamp = string(in.String())
+		case "TimestampGTE":
+			out.TimestampGTE = string(in.String())
+		case "TimestampGT":
+			out.TimestampGT = string(in.String())
+		case "TimestampLTE":
+			out.TimestampLTE = string(in.String())
+		case "TimestampLT":
+			out.TimestampLT = string(in.String())
+		case "Order":
+			out.Order = Sort(in.String())
+		case "Limit":
+			out.Limit = int32(in.Int32())
 		case "Sort":
-			out.Sort = TickerSort(in.String())
-		case "Type":
-			out.Type = string(in.String())
-		case "Market":
-			out.Market = Market(in.String())
-		case "Locale":
-			out.Locale = Locale(in.String())
-		case "Search":
-			out.Search = string(in.String())
-		case "PerPage":
-			out.PerPage = int32(in.Int32())
-		case "Page":
-			out.Page = int32(in.Int32())
-		case "Active":
-			out.Active = bool(in.Bool())
+			out.Sort = string(in.String())
+		case "Cursor":
+			out.Cursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -244,77 +344,82 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(out *jwriter.Writer, in TickerOptions) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(out *jwriter.Writer, in V3TickOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Sort\":"
+		const prefix string = ",\"Timestamp\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Sort))
+		out.String(string(in.Timestamp))
 	}
 	{
-		const prefix string = ",\"Type\":"
+		const prefix string = ",\"TimestampGTE\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.TimestampGTE))
 	}
 	{
-		const prefix string = ",\"Market\":"
+		const prefix string = ",\"TimestampGT\":"
 		out.RawString(prefix)
-		out.String(string(in.Market))
+		out.String(string(in.TimestampGT))
 	}
 	{
-		const prefix string = ",\"Locale\":"
+		const prefix string = ",\"TimestampLTE\":"
 		out.RawString(prefix)
-		out.String(string(in.Locale))
+		out.String(string(in.TimestampLTE))
 	}
 	{
-		const prefix string = ",\"Search\":"
+		const prefix string = ",\"TimestampLT\":"
 		out.RawString(prefix)
-		out.String(string(in.Search))
+		out.String(string(in.TimestampLT))
 	}
 	{
-		const prefix string = ",\"PerPage\":"
+		const prefix string = ",\"Order\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.PerPage))
+		out.String(string(in.Order))
 	}
 	{
-		const prefix string = ",\"Page\":"
+		const prefix string = ",\"Limit\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Page))
+		out.Int32(int32(in.Limit))
 	}
 	{
-		const prefix string = ",\"Active\":"
+		const prefix string = ",\"Sort\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Active))
+		out.String(string(in.Sort))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.Cursor))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TickerOptions) MarshalJSON() ([]byte, error) {
+func (v V3TickOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TickerOptions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient1(w, v)
+func (v V3TickOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TickerOptions) UnmarshalJSON(data []byte) error {
+func (v *V3TickOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TickerOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient1(l, v)
+func (v *V3TickOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(in *jlexer.Lexer, out *TickerNews) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(in *jlexer.Lexer, out *V3QuotesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -333,66 +438,31 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "symbols":
+		case "results":
 			if in.IsNull() {
 				in.Skip()
-				out.Symbols = nil
+				out.Results = nil
 			} else {
 				in.Delim('[')
-				if out.Symbols == nil {
+				if out.Results == nil {
 					if !in.IsDelim(']') {
-						out.Symbols = make([]string, 0, 4)
+						out.Results = make(V3Quotes, 0, 0)
 					} else {
-						out.Symbols = []string{}
+						out.Results = V3Quotes{}
 					}
 				} else {
-					out.Symbols = (out.Symbols)[:0]
+					out.Results = (out.Results)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 string
-					v4 = string(in.String())
-					out.Symbols = append(out.Symbols, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "title":
-			out.Title = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "source":
-			out.Source = string(in.String())
-		case "summary":
-			out.Summary = string(in.String())
-		case "image":
-			out.Image = string(in.String())
-		case "timestamp":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Timestamp).UnmarshalJSON(data))
-			}
-		case "keywords":
-			if in.IsNull() {
-				in.Skip()
-				out.Keywords = nil
-			} else {
-				in.Delim('[')
-				if out.Keywords == nil {
-					if !in.IsDelim(']') {
-						out.Keywords = make([]string, 0, 4)
-					} else {
-						out.Keywords = []string{}
-					}
-				} else {
-					out.Keywords = (out.Keywords)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v5 string
-					v5 = string(in.String())
-					out.Keywords = append(out.Keywords, v5)
+					var v7 V3Quote
+					(v7).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "next_url":
+			out.NextURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -403,99 +473,58 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(out *jwriter.Writer, in TickerNews) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(out *jwriter.Writer, in V3QuotesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"symbols\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix[1:])
-		if in.Symbols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Symbols {
-				if v6 > 0 {
+			for v8, v9 := range in.Results {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v7))
+				(v9).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"url\":"
-		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	{
-		const prefix string = ",\"source\":"
-		out.RawString(prefix)
-		out.String(string(in.Source))
-	}
-	{
-		const prefix string = ",\"summary\":"
-		out.RawString(prefix)
-		out.String(string(in.Summary))
-	}
-	{
-		const prefix string = ",\"image\":"
-		out.RawString(prefix)
-		out.String(string(in.Image))
-	}
-	{
-		const prefix string = ",\"timestamp\":"
-		out.RawString(prefix)
-		out.Raw((in.Timestamp).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"keywords\":"
+		const prefix string = ",\"next_url\":"
 		out.RawString(prefix)
-		if in.Keywords == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Keywords {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v9))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.NextURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TickerNews) MarshalJSON() ([]byte, error) {
+func (v V3QuotesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TickerNews) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient2(w, v)
+func (v V3QuotesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TickerNews) UnmarshalJSON(data []byte) error {
+func (v *V3QuotesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TickerNews) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient2(l, v)
+func (v *V3QuotesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(in *jlexer.Lexer, out *TickerDetails) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(in *jlexer.Lexer, out *V3Quote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -514,104 +543,74 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "logo":
-			out.Logo = string(in.String())
-		case "listdate":
-			out.ListDate = string(in.String())
-		case "cik":
-			out.CIK = string(in.String())
-		case "bloomberg":
-			out.Bloomberg = string(in.String())
-		case "figi":
-			out.FIGI = string(in.String())
-		case "lei":
-			out.LEI = string(in.String())
-		case "sic":
-			out.SIC = int32(in.Int32())
-		case "country":
-			out.Country = string(in.String())
-		case "industry":
-			out.Industry = string(in.String())
-		case "sector":
-			out.Sector = string(in.String())
-		case "marketcap":
-			out.MarketCap = int64(in.Int64())
-		case "employees":
-			out.Employees = int64(in.Int64())
-		case "phone":
-			out.Phone = string(in.String())
-		case "ceo":
-			out.CEO = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "exchange":
-			out.Exchange = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "symbol":
-			out.Symbol = string(in.String())
-		case "exchangeSymbol":
-			out.ExchangeSymbol = string(in.String())
-		case "hq_address":
-			out.HQAddress = string(in.String())
-		case "hq_state":
-			out.HQState = string(in.String())
-		case "hq_country":
-			out.HQCountry = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "updated":
-			out.Updated = string(in.String())
-		case "tags":
+		case "bid_exchange":
+			out.BidExchange = int32(in.Int32())
+		case "bid_price":
+			out.BidPrice = float64(in.Float64())
+		case "bid_size":
+			out.BidSize = float64(in.Float64())
+		case "ask_exchange":
+			out.AskExchange = int32(in.Int32())
+		case "ask_price":
+			out.AskPrice = float64(in.Float64())
+		case "ask_size":
+			out.AskSize = float64(in.Float64())
+		case "conditions":
 			if in.IsNull() {
 				in.Skip()
-				out.Tags = nil
+				out.Conditions = nil
 			} else {
 				in.Delim('[')
-				if out.Tags == nil {
+				if out.Conditions == nil {
 					if !in.IsDelim(']') {
-						out.Tags = make([]string, 0, 4)
+						out.Conditions = make([]int32, 0, 16)
 					} else {
-						out.Tags = []string{}
+						out.Conditions = []int32{}
 					}
 				} else {
-					out.Tags = (out.Tags)[:0]
+					out.Conditions = (out.Conditions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Tags = append(out.Tags, v10)
+					var v10 int32
+					v10 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "similar":
+		case "indicators":
 			if in.IsNull() {
 				in.Skip()
-				out.Similar = nil
+				out.Indicators = nil
 			} else {
 				in.Delim('[')
-				if out.Similar == nil {
+				if out.Indicators == nil {
 					if !in.IsDelim(']') {
-						out.Similar = make([]string, 0, 4)
+						out.Indicators = make([]int32, 0, 16)
 					} else {
-						out.Similar = []string{}
+						out.Indicators = []int32{}
 					}
 				} else {
-					out.Similar = (out.Similar)[:0]
+					out.Indicators = (out.Indicators)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v11 string
-					v11 = string(in.String())
-					out.Similar = append(out.Similar, v11)
+					var v11 int32
+					v11 = int32(in.Int32())
+					out.Indicators = append(out.Indicators, v11)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "active":
-			out.Active = bool(in.Bool())
+		case "sequence_number":
+			out.SequenceNumber = int64(in.Int64())
+		case "sip_timestamp":
+			out.SIPTimestamp = int64(in.Int64())
+		case "participant_timestamp":
+			out.ParticipantTimestamp = int64(in.Int64())
+		case "trf_timestamp":
+			out.TRFTimestamp = int64(in.Int64())
+		case "tape":
+			out.Tape = int32(in.Int32())
 		default:
 			in.SkipRecursive()
 		}
@@ -622,199 +621,211 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(out *jwriter.Writer, in TickerDetails) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(out *jwriter.Writer, in V3Quote) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"logo\":"
+		const prefix string = ",\"bid_exchange\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Logo))
-	}
-	{
-		const prefix string = ",\"listdate\":"
-		out.RawString(prefix)
-		out.String(string(in.ListDate))
-	}
-	{
-		const prefix string = ",\"cik\":"
-		out.RawString(prefix)
-		out.String(string(in.CIK))
-	}
-	{
-		const prefix string = ",\"bloomberg\":"
-		out.RawString(prefix)
-		out.String(string(in.Bloomberg))
+		out.Int32(int32(in.BidExchange))
 	}
 	{
-		const prefix string = ",\"figi\":"
+		const prefix string = ",\"bid_price\":"
 		out.RawString(prefix)
-		out.String(string(in.FIGI))
+		out.Float64(float64(in.BidPrice))
 	}
 	{
-		const prefix string = ",\"lei\":"
+		const prefix string = ",\"bid_size\":"
 		out.RawString(prefix)
-		out.String(string(in.LEI))
+		out.Float64(float64(in.BidSize))
 	}
 	{
-		const prefix string = ",\"sic\":"
+		const prefix string = ",\"ask_exchange\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.SIC))
+		out.Int32(int32(in.AskExchange))
 	}
 	{
-		const prefix string = ",\"country\":"
+		const prefix string = ",\"ask_price\":"
 		out.RawString(prefix)
-		out.String(string(in.Country))
+		out.Float64(float64(in.AskPrice))
 	}
 	{
-		const prefix string = ",\"industry\":"
+		const prefix string = ",\"ask_size\":"
 		out.RawString(prefix)
-		out.String(string(in.Industry))
+		out.Float64(float64(in.AskSize))
 	}
 	{
-		const prefix string = ",\"sector\":"
+		const prefix string = ",\"conditions\":"
 		out.RawString(prefix)
-		out.String(string(in.Sector))
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Conditions {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v13))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"marketcap\":"
+		const prefix string = ",\"indicators\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.MarketCap))
+		if in.Indicators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Indicators {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v15))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"employees\":"
+		const prefix string = ",\"sequence_number\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Employees))
+		out.Int64(int64(in.SequenceNumber))
 	}
 	{
-		const prefix string = ",\"phone\":"
+		const prefix string = ",\"sip_timestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.Phone))
+		out.Int64(int64(in.SIPTimestamp))
 	}
 	{
-		const prefix string = ",\"ceo\":"
+		const prefix string = ",\"participant_timestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.CEO))
+		out.Int64(int64(in.ParticipantTimestamp))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"trf_timestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.Int64(int64(in.TRFTimestamp))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"tape\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Int32(int32(in.Tape))
 	}
-	{
-		const prefix string = ",\"exchange\":"
-		out.RawString(prefix)
-		out.String(string(in.Exchange))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v V3Quote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v V3Quote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *V3Quote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *V3Quote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(in *jlexer.Lexer, out *UnderlyingAsset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"symbol\":"
-		out.RawString(prefix)
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"exchangeSymbol\":"
-		out.RawString(prefix)
-		out.String(string(in.ExchangeSymbol))
-	}
-	{
-		const prefix string = ",\"hq_address\":"
-		out.RawString(prefix)
-		out.String(string(in.HQAddress))
-	}
-	{
-		const prefix string = ",\"hq_state\":"
-		out.RawString(prefix)
-		out.String(string(in.HQState))
-	}
-	{
-		const prefix string = ",\"hq_country\":"
-		out.RawString(prefix)
-		out.String(string(in.HQCountry))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "change_to_break_even":
+			out.ChangeToBreakEven = float64(in.Float64())
+		case "last_updated":
+			out.LastUpdated = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(out *jwriter.Writer, in UnderlyingAsset) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"updated\":"
-		out.RawString(prefix)
-		out.String(string(in.Updated))
+		const prefix string = ",\"ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"tags\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v12, v13 := range in.Tags {
-				if v12 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v13))
-			}
-			out.RawByte(']')
-		}
+		out.Float64(float64(in.Price))
 	}
 	{
-		const prefix string = ",\"similar\":"
+		const prefix string = ",\"change_to_break_even\":"
 		out.RawString(prefix)
-		if in.Similar == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Similar {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v15))
-			}
-			out.RawByte(']')
-		}
+		out.Float64(float64(in.ChangeToBreakEven))
 	}
 	{
-		const prefix string = ",\"active\":"
+		const prefix string = ",\"last_updated\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Active))
+		out.Int64(int64(in.LastUpdated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TickerDetails) MarshalJSON() ([]byte, error) {
+func (v UnderlyingAsset) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TickerDetails) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient3(w, v)
+func (v UnderlyingAsset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TickerDetails) UnmarshalJSON(data []byte) error {
+func (v *UnderlyingAsset) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TickerDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient3(l, v)
+func (v *UnderlyingAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(in *jlexer.Lexer, out *Ticker) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(in *jlexer.Lexer, out *Trade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -833,88 +844,53 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "ticker":
-			out.Ticker = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "market":
-			out.Market = string(in.String())
-		case "locale":
-			out.Locale = Locale(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "currency":
-			out.Currency = string(in.String())
-		case "active":
-			out.Active = bool(in.Bool())
-		case "primaryExch":
-			out.PrimaryExch = string(in.String())
-		case "updated":
-			out.Updated = string(in.String())
-		case "codes":
+		case "I":
+			out.ID = int64(in.Int64())
+		case "x":
+			out.Exchange = int32(in.Int32())
+		case "p":
+			out.Price = float64(in.Float64())
+		case "i":
+			out.TradeID = string(in.String())
+		case "e":
+			out.CorrID = int32(in.Int32())
+		case "r":
+			out.ReportID = int32(in.Int32())
+		case "y":
+			out.ExTime = int64(in.Int64())
+		case "t":
+			out.SIPTime = int64(in.Int64())
+		case "f":
+			out.TRFTime = int64(in.Int64())
+		case "c":
 			if in.IsNull() {
 				in.Skip()
-				out.Codes = nil
+				out.Conditions = nil
 			} else {
-				if out.Codes == nil {
-					out.Codes = new(CodesMap)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					in.Delim('{')
-					if !in.IsDelim('}') {
-						*out.Codes = make(CodesMap)
+				in.Delim('[')
+				if out.Conditions == nil {
+					if !in.IsDelim(']') {
+						out.Conditions = make([]int32, 0, 16)
 					} else {
-						*out.Codes = nil
-					}
-					for !in.IsDelim('}') {
-						key := string(in.String())
-						in.WantColon()
-						var v16 string
-						v16 = string(in.String())
-						(*out.Codes)[key] = v16
-						in.WantComma()
+						out.Conditions = []int32{}
 					}
-					in.Delim('}')
-				}
-			}
-		case "attrs":
-			if in.IsNull() {
-				in.Skip()
-				out.Attrs = nil
-			} else {
-				if out.Attrs == nil {
-					out.Attrs = new(AttrsMap)
-				}
-				if in.IsNull() {
-					in.Skip()
 				} else {
-					in.Delim('{')
-					if !in.IsDelim('}') {
-						*out.Attrs = make(AttrsMap)
-					} else {
-						*out.Attrs = nil
-					}
-					for !in.IsDelim('}') {
-						key := string(in.String())
-						in.WantColon()
-						var v17 interface{}
-						if m, ok := v17.(easyjson.Unmarshaler); ok {
-							m.UnmarshalEasyJSON(in)
-						} else if m, ok := v17.(json.Unmarshaler); ok {
-							_ = m.UnmarshalJSON(in.Raw())
-						} else {
-							v17 = in.Interface()
-						}
-						(*out.Attrs)[key] = v17
-						in.WantComma()
-					}
-					in.Delim('}')
+					out.Conditions = (out.Conditions)[:0]
 				}
+				for !in.IsDelim(']') {
+					var v16 int32
+					v16 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "url":
-			out.URL = string(in.String())
+		case "q":
+			out.Sequence = int32(in.Int32())
+		case "s":
+			out.Size = int32(in.Int32())
+		case "z":
+			out.ListedEx = int32(in.Int32())
 		default:
 			in.SkipRecursive()
 		}
@@ -925,201 +901,113 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(out *jwriter.Writer, in Ticker) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(out *jwriter.Writer, in Trade) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ticker\":"
+		const prefix string = ",\"I\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Ticker))
+		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"x\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"market\":"
+		const prefix string = ",\"p\":"
 		out.RawString(prefix)
-		out.String(string(in.Market))
+		out.Float64(float64(in.Price))
 	}
 	{
-		const prefix string = ",\"locale\":"
+		const prefix string = ",\"i\":"
 		out.RawString(prefix)
-		out.String(string(in.Locale))
+		out.String(string(in.TradeID))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"e\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Int32(int32(in.CorrID))
 	}
 	{
-		const prefix string = ",\"currency\":"
+		const prefix string = ",\"r\":"
 		out.RawString(prefix)
-		out.String(string(in.Currency))
+		out.Int32(int32(in.ReportID))
 	}
 	{
-		const prefix string = ",\"active\":"
+		const prefix string = ",\"y\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Active))
+		out.Int64(int64(in.ExTime))
 	}
 	{
-		const prefix string = ",\"primaryExch\":"
+		const prefix string = ",\"t\":"
 		out.RawString(prefix)
-		out.String(string(in.PrimaryExch))
+		out.Int64(int64(in.SIPTime))
 	}
 	{
-		const prefix string = ",\"updated\":"
+		const prefix string = ",\"f\":"
 		out.RawString(prefix)
-		out.String(string(in.Updated))
+		out.Int64(int64(in.TRFTime))
 	}
-	if in.Codes != nil {
-		const prefix string = ",\"codes\":"
+	{
+		const prefix string = ",\"c\":"
 		out.RawString(prefix)
-		if *in.Codes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawByte('{')
-			v18First := true
-			for v18Name, v18Value := range *in.Codes {
-				if v18First {
-					v18First = false
-				} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Conditions {
+				if v17 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v18Name))
-				out.RawByte(':')
-				out.String(string(v18Value))
+				out.Int32(int32(v18))
 			}
-			out.RawByte('}')
+			out.RawByte(']')
 		}
 	}
-	if in.Attrs != nil {
-		const prefix string = ",\"attrs\":"
+	{
+		const prefix string = ",\"q\":"
 		out.RawString(prefix)
-		if *in.Attrs == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v19First := true
-			for v19Name, v19Value := range *in.Attrs {
-				if v19First {
-					v19First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v19Name))
-				out.RawByte(':')
-				if m, ok := v19Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v19Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v19Value))
-				}
-			}
-			out.RawByte('}')
-		}
+		out.Int32(int32(in.Sequence))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"s\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Ticker) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Ticker) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient4(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Ticker) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Ticker) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient4(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(in *jlexer.Lexer, out *StreamingServerMsges) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(StreamingServerMsges, 0, 0)
-			} else {
-				*out = StreamingServerMsges{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v20 StreamingServerMsg
-			(v20).UnmarshalEasyJSON(in)
-			*out = append(*out, v20)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
+		out.Int32(int32(in.Size))
 	}
-}
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(out *jwriter.Writer, in StreamingServerMsges) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v21, v22 := range in {
-			if v21 > 0 {
-				out.RawByte(',')
-			}
-			(v22).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ListedEx))
 	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StreamingServerMsges) MarshalJSON() ([]byte, error) {
+func (v Trade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamingServerMsges) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient5(w, v)
+func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamingServerMsges) UnmarshalJSON(data []byte) error {
+func (v *Trade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamingServerMsges) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient5(l, v)
+func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(in *jlexer.Lexer, out *StreamingServerMsg) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(in *jlexer.Lexer, out *TickerOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1138,60 +1026,22 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "ev":
-			out.Event = string(in.String())
-		case "sym":
-			out.Symbol = string(in.String())
-		case "x":
-			out.Exchange = int32(in.Int32())
-		case "i":
-			out.TradeID = string(in.String())
-		case "p":
-			out.Price = float32(in.Float32())
-		case "s":
-			out.S = int64(in.Int64())
-		case "c":
-			if m, ok := out.C.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.C.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.C = in.Interface()
-			}
-		case "t":
-			out.Timestamp = int64(in.Int64())
-		case "z":
-			out.Trade = int64(in.Int64())
-		case "bx":
-			out.BidExchange = int32(in.Int32())
-		case "ax":
-			out.AskExchange = int32(in.Int32())
-		case "bp":
-			out.BidPrice = float32(in.Float32())
-		case "ap":
-			out.AskPrice = float32(in.Float32())
-		case "bs":
-			out.BidSize = int32(in.Int32())
-		case "as":
-			out.AskSize = int32(in.Int32())
-		case "v":
-			out.Volume = int32(in.Int32())
-		case "av":
-			out.AccumulatedVolume = int64(in.Int64())
-		case "op":
-			out.OpeningPrice = float32(in.Float32())
-		case "vw":
-			out.VWAP = float32(in.Float32())
-		case "o":
-			out.OpenPrice = float32(in.Float32())
-		case "h":
-			out.HighPrice = float32(in.Float32())
-		case "l":
-			out.LowPrice = float32(in.Float32())
-		case "a":
-			out.Average = float32(in.Float32())
-		case "e":
-			out.EndTimestamp = int64(in.Int64())
+		case "Sort":
+			out.Sort = TickerSort(in.String())
+		case "Type":
+			out.Type = string(in.String())
+		case "Market":
+			out.Market = Market(in.String())
+		case "Locale":
+			out.Locale = Locale(in.String())
+		case "Search":
+			out.Search = string(in.String())
+		case "PerPage":
+			out.PerPage = int32(in.Int32())
+		case "Page":
+			out.Page = int32(in.Int32())
+		case "Active":
+			out.Active = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1202,236 +1052,84 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(out *jwriter.Writer, in StreamingServerMsg) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(out *jwriter.Writer, in TickerOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ev\":"
+		const prefix string = ",\"Sort\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Event))
-	}
-	{
-		const prefix string = ",\"sym\":"
-		out.RawString(prefix)
-		out.String(string(in.Symbol))
-	}
-	{
-		const prefix string = ",\"x\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.Exchange))
+		out.String(string(in.Sort))
 	}
 	{
-		const prefix string = ",\"i\":"
+		const prefix string = ",\"Type\":"
 		out.RawString(prefix)
-		out.String(string(in.TradeID))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"p\":"
+		const prefix string = ",\"Market\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Price))
+		out.String(string(in.Market))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"Locale\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.S))
+		out.String(string(in.Locale))
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"Search\":"
 		out.RawString(prefix)
-		if m, ok := in.C.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.C.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.C))
-		}
+		out.String(string(in.Search))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"PerPage\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		out.Int32(int32(in.PerPage))
 	}
 	{
-		const prefix string = ",\"z\":"
+		const prefix string = ",\"Page\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Trade))
+		out.Int32(int32(in.Page))
 	}
 	{
-		const prefix string = ",\"bx\":"
+		const prefix string = ",\"Active\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.BidExchange))
+		out.Bool(bool(in.Active))
 	}
-	{
-		const prefix string = ",\"ax\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.AskExchange))
-	}
-	{
-		const prefix string = ",\"bp\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.BidPrice))
-	}
-	{
-		const prefix string = ",\"ap\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.AskPrice))
-	}
-	{
-		const prefix string = ",\"bs\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.BidSize))
-	}
-	{
-		const prefix string = ",\"as\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.AskSize))
-	}
-	{
-		const prefix string = ",\"v\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.Volume))
-	}
-	{
-		const prefix string = ",\"av\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.AccumulatedVolume))
-	}
-	{
-		const prefix string = ",\"op\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.OpeningPrice))
-	}
-	{
-		const prefix string = ",\"vw\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.VWAP))
-	}
-	{
-		const prefix string = ",\"o\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.OpenPrice))
-	}
-	{
-		const prefix string = ",\"h\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.HighPrice))
-	}
-	{
-		const prefix string = ",\"l\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.LowPrice))
-	}
-	{
-		const prefix string = ",\"a\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Average))
-	}
-	{
-		const prefix string = ",\"e\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.EndTimestamp))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v StreamingServerMsg) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamingServerMsg) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient6(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamingServerMsg) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamingServerMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient6(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(in *jlexer.Lexer, out *StreamTrades) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(StreamTrades, 0, 0)
-			} else {
-				*out = StreamTrades{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v23 StreamTrade
-			(v23).UnmarshalEasyJSON(in)
-			*out = append(*out, v23)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(out *jwriter.Writer, in StreamTrades) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v24, v25 := range in {
-			if v24 > 0 {
-				out.RawByte(',')
-			}
-			(v25).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
-	}
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v StreamTrades) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamTrades) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamTrades) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamTrades) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(in *jlexer.Lexer, out *StreamTrade) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TickerOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TickerOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TickerOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TickerOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient7(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(in *jlexer.Lexer, out *TickerNews) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
@@ -1443,45 +1141,66 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "ev":
-			out.Event = string(in.String())
-		case "sym":
-			out.Symbol = string(in.String())
-		case "x":
-			out.Exchange = int32(in.Int32())
-		case "i":
-			out.TradeID = string(in.String())
-		case "p":
-			out.Price = float32(in.Float32())
-		case "s":
-			out.Size = int32(in.Int32())
-		case "t":
-			out.Timestamp = int64(in.Int64())
-		case "c":
+		case "symbols":
 			if in.IsNull() {
 				in.Skip()
-				out.Conditions = nil
+				out.Symbols = nil
 			} else {
 				in.Delim('[')
-				if out.Conditions == nil {
+				if out.Symbols == nil {
 					if !in.IsDelim(']') {
-						out.Conditions = make([]int32, 0, 16)
+						out.Symbols = make([]string, 0, 4)
 					} else {
-						out.Conditions = []int32{}
+						out.Symbols = []string{}
 					}
 				} else {
-					out.Conditions = (out.Conditions)[:0]
+					out.Symbols = (out.Symbols)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v26 int32
-					v26 = int32(in.Int32())
-					out.Conditions = append(out.Conditions, v26)
+					var v19 string
+					v19 = string(in.String())
+					out.Symbols = append(out.Symbols, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "source":
+			out.Source = string(in.String())
+		case "summary":
+			out.Summary = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "timestamp":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Timestamp).UnmarshalJSON(data))
+			}
+		case "keywords":
+			if in.IsNull() {
+				in.Skip()
+				out.Keywords = nil
+			} else {
+				in.Delim('[')
+				if out.Keywords == nil {
+					if !in.IsDelim(']') {
+						out.Keywords = make([]string, 0, 4)
+					} else {
+						out.Keywords = []string{}
+					}
+				} else {
+					out.Keywords = (out.Keywords)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 string
+					v20 = string(in.String())
+					out.Keywords = append(out.Keywords, v20)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "z":
-			out.Unknown = int32(in.Int32())
 		default:
 			in.SkipRecursive()
 		}
@@ -1492,159 +1211,99 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient8(out *jwriter.Writer, in StreamTrade) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient8(out *jwriter.Writer, in TickerNews) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ev\":"
+		const prefix string = ",\"symbols\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Event))
+		if in.Symbols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Symbols {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v22))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"sym\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.String(string(in.Symbol))
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"x\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Exchange))
+		out.String(string(in.URL))
 	}
 	{
-		const prefix string = ",\"i\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix)
-		out.String(string(in.TradeID))
+		out.String(string(in.Source))
 	}
 	{
-		const prefix string = ",\"p\":"
+		const prefix string = ",\"summary\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Price))
+		out.String(string(in.Summary))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"image\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Size))
+		out.String(string(in.Image))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"timestamp\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		out.Raw((in.Timestamp).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"keywords\":"
 		out.RawString(prefix)
-		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Keywords == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v27, v28 := range in.Conditions {
-				if v27 > 0 {
+			for v23, v24 := range in.Keywords {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				out.Int32(int32(v28))
+				out.String(string(v24))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"z\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.Unknown))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StreamTrade) MarshalJSON() ([]byte, error) {
+func (v TickerNews) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamTrade) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TickerNews) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamTrade) UnmarshalJSON(data []byte) error {
+func (v *TickerNews) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TickerNews) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient8(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(in *jlexer.Lexer, out *StreamQuotes) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(StreamQuotes, 0, 0)
-			} else {
-				*out = StreamQuotes{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v29 StreamQuote
-			(v29).UnmarshalEasyJSON(in)
-			*out = append(*out, v29)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(out *jwriter.Writer, in StreamQuotes) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v30, v31 := range in {
-			if v30 > 0 {
-				out.RawByte(',')
-			}
-			(v31).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
-	}
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v StreamQuotes) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamQuotes) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamQuotes) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamQuotes) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(in *jlexer.Lexer, out *StreamQuote) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(in *jlexer.Lexer, out *TickerDetails) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1663,28 +1322,104 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "ev":
-			out.Event = string(in.String())
-		case "sym":
+		case "logo":
+			out.Logo = string(in.String())
+		case "listdate":
+			out.ListDate = string(in.String())
+		case "cik":
+			out.CIK = string(in.String())
+		case "bloomberg":
+			out.Bloomberg = string(in.String())
+		case "figi":
+			out.FIGI = string(in.String())
+		case "lei":
+			out.LEI = string(in.String())
+		case "sic":
+			out.SIC = int32(in.Int32())
+		case "country":
+			out.Country = string(in.String())
+		case "industry":
+			out.Industry = string(in.String())
+		case "sector":
+			out.Sector = string(in.String())
+		case "marketcap":
+			out.MarketCap = int64(in.Int64())
+		case "employees":
+			out.Employees = int64(in.Int64())
+		case "phone":
+			out.Phone = string(in.String())
+		case "ceo":
+			out.CEO = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "symbol":
 			out.Symbol = string(in.String())
-		case "c":
-			out.Condition = int32(in.Int32())
-		case "bx":
-			out.BidExchange = int32(in.Int32())
-		case "ax":
-			out.AskExchange = int32(in.Int32())
-		case "bp":
-			out.BidPrice = float32(in.Float32())
-		case "ap":
-			out.AskPrice = float32(in.Float32())
-		case "bs":
-			out.BidSize = int32(in.Int32())
-		case "as":
-			out.AskSize = int32(in.Int32())
-		case "t":
-			out.Timestamp = int64(in.Int64())
-		case "z":
-			out.Unknown = int32(in.Int32())
+		case "exchangeSymbol":
+			out.ExchangeSymbol = string(in.String())
+		case "hq_address":
+			out.HQAddress = string(in.String())
+		case "hq_state":
+			out.HQState = string(in.String())
+		case "hq_country":
+			out.HQCountry = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "updated":
+			out.Updated = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.Tags = append(out.Tags, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "similar":
+			if in.IsNull() {
+				in.Skip()
+				out.Similar = nil
+			} else {
+				in.Delim('[')
+				if out.Similar == nil {
+					if !in.IsDelim(']') {
+						out.Similar = make([]string, 0, 4)
+					} else {
+						out.Similar = []string{}
+					}
+				} else {
+					out.Similar = (out.Similar)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 string
+					v26 = string(in.String())
+					out.Similar = append(out.Similar, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "active":
+			out.Active = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1695,315 +1430,199 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(out *jwriter.Writer, in StreamQuote) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(out *jwriter.Writer, in TickerDetails) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ev\":"
+		const prefix string = ",\"logo\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Event))
+		out.String(string(in.Logo))
 	}
 	{
-		const prefix string = ",\"sym\":"
+		const prefix string = ",\"listdate\":"
 		out.RawString(prefix)
-		out.String(string(in.Symbol))
+		out.String(string(in.ListDate))
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"cik\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Condition))
+		out.String(string(in.CIK))
 	}
 	{
-		const prefix string = ",\"bx\":"
+		const prefix string = ",\"bloomberg\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.BidExchange))
+		out.String(string(in.Bloomberg))
 	}
 	{
-		const prefix string = ",\"ax\":"
+		const prefix string = ",\"figi\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskExchange))
+		out.String(string(in.FIGI))
 	}
 	{
-		const prefix string = ",\"bp\":"
+		const prefix string = ",\"lei\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.BidPrice))
+		out.String(string(in.LEI))
 	}
 	{
-		const prefix string = ",\"ap\":"
+		const prefix string = ",\"sic\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.AskPrice))
+		out.Int32(int32(in.SIC))
 	}
 	{
-		const prefix string = ",\"bs\":"
+		const prefix string = ",\"country\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.BidSize))
+		out.String(string(in.Country))
 	}
 	{
-		const prefix string = ",\"as\":"
+		const prefix string = ",\"industry\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskSize))
+		out.String(string(in.Industry))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"sector\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		out.String(string(in.Sector))
 	}
 	{
-		const prefix string = ",\"z\":"
+		const prefix string = ",\"marketcap\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Unknown))
+		out.Int64(int64(in.MarketCap))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v StreamQuote) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamQuote) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamQuote) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(in *jlexer.Lexer, out *StreamAggregates) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(StreamAggregates, 0, 0)
-			} else {
-				*out = StreamAggregates{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v32 StreamAggregate
-			(v32).UnmarshalEasyJSON(in)
-			*out = append(*out, v32)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(out *jwriter.Writer, in StreamAggregates) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v33, v34 := range in {
-			if v33 > 0 {
-				out.RawByte(',')
-			}
-			(v34).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
-	}
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v StreamAggregates) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamAggregates) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamAggregates) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamAggregates) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(l, v)
-}
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(in *jlexer.Lexer, out *StreamAggregate) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"employees\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Employees))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "ev":
-			out.Event = string(in.String())
-		case "sym":
-			out.Symbol = string(in.String())
-		case "v":
-			out.Volume = int32(in.Int32())
-		case "av":
-			out.AccumulatedVolume = int64(in.Int64())
-		case "op":
-			out.OpeningPrice = float32(in.Float32())
-		case "vw":
-			out.VWAP = float32(in.Float32())
-		case "o":
-			out.OpenPrice = float32(in.Float32())
-		case "c":
-			out.ClosePrice = float32(in.Float32())
-		case "h":
-			out.HighPrice = float32(in.Float32())
-		case "l":
-			out.LowPrice = float32(in.Float32())
-		case "a":
-			out.Average = float32(in.Float32())
-		case "TotalTrade":
-			out.TotalTrade = int32(in.Int32())
-		case "s":
-			out.StartTimestamp = int64(in.Int64())
-		case "e":
-			out.EndTimestamp = int64(in.Int64())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"ceo\":"
+		out.RawString(prefix)
+		out.String(string(in.CEO))
 	}
-}
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(out *jwriter.Writer, in StreamAggregate) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"ev\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Event))
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
 	}
 	{
-		const prefix string = ",\"sym\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.Symbol))
+		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"v\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Volume))
+		out.String(string(in.Exchange))
 	}
 	{
-		const prefix string = ",\"av\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.AccumulatedVolume))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"op\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.OpeningPrice))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"vw\":"
+		const prefix string = ",\"exchangeSymbol\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.VWAP))
+		out.String(string(in.ExchangeSymbol))
 	}
 	{
-		const prefix string = ",\"o\":"
+		const prefix string = ",\"hq_address\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.OpenPrice))
+		out.String(string(in.HQAddress))
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"hq_state\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ClosePrice))
+		out.String(string(in.HQState))
 	}
 	{
-		const prefix string = ",\"h\":"
+		const prefix string = ",\"hq_country\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.HighPrice))
+		out.String(string(in.HQCountry))
 	}
 	{
-		const prefix string = ",\"l\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.LowPrice))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"a\":"
+		const prefix string = ",\"updated\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Average))
+		out.String(string(in.Updated))
 	}
 	{
-		const prefix string = ",\"TotalTrade\":"
+		const prefix string = ",\"tags\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.TotalTrade))
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Tags {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v28))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"similar\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.StartTimestamp))
+		if in.Similar == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Similar {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v30))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"e\":"
+		const prefix string = ",\"active\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.EndTimestamp))
+		out.Bool(bool(in.Active))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StreamAggregate) MarshalJSON() ([]byte, error) {
+func (v TickerDetails) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamAggregate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(w, v)
+func (v TickerDetails) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamAggregate) UnmarshalJSON(data []byte) error {
+func (v *TickerDetails) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamAggregate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(l, v)
+func (v *TickerDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient9(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(in *jlexer.Lexer, out *StockTradesResponse) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(in *jlexer.Lexer, out *Ticker) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2022,29 +1641,88 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "results":
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "market":
+			out.Market = string(in.String())
+		case "locale":
+			out.Locale = Locale(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
+		case "active":
+			out.Active = bool(in.Bool())
+		case "primaryExch":
+			out.PrimaryExch = string(in.String())
+		case "updated":
+			out.Updated = string(in.String())
+		case "codes":
 			if in.IsNull() {
 				in.Skip()
-				out.Results = nil
+				out.Codes = nil
 			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make(Trades, 0, 0)
+				if out.Codes == nil {
+					out.Codes = new(CodesMap)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					in.Delim('{')
+					if !in.IsDelim('}') {
+						*out.Codes = make(CodesMap)
 					} else {
-						out.Results = Trades{}
+						*out.Codes = nil
 					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v35 Trade
-					(v35).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v35)
-					in.WantComma()
+					for !in.IsDelim('}') {
+						key := string(in.String())
+						in.WantColon()
+						var v31 string
+						v31 = string(in.String())
+						(*out.Codes)[key] = v31
+						in.WantComma()
+					}
+					in.Delim('}')
+				}
+			}
+		case "attrs":
+			if in.IsNull() {
+				in.Skip()
+				out.Attrs = nil
+			} else {
+				if out.Attrs == nil {
+					out.Attrs = new(AttrsMap)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					in.Delim('{')
+					if !in.IsDelim('}') {
+						*out.Attrs = make(AttrsMap)
+					} else {
+						*out.Attrs = nil
+					}
+					for !in.IsDelim('}') {
+						key := string(in.String())
+						in.WantColon()
+						var v32 interface{}
+						if m, ok := v32.(easyjson.Unmarshaler); ok {
+							m.UnmarshalEasyJSON(in)
+						} else if m, ok := v32.(json.Unmarshaler); ok {
+							_ = m.UnmarshalJSON(in.Raw())
+						} else {
+							v32 = in.Interface()
+						}
+						(*out.Attrs)[key] = v32
+						in.WantComma()
+					}
+					in.Delim('}')
 				}
-				in.Delim(']')
 			}
+		case "url":
+			out.URL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2055,151 +1733,201 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(out *jwriter.Writer, in StockTradesResponse) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(out *jwriter.Writer, in Ticker) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"ticker\":"
 		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix)
+		out.String(string(in.Market))
+	}
+	{
+		const prefix string = ",\"locale\":"
+		out.RawString(prefix)
+		out.String(string(in.Locale))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Active))
+	}
+	{
+		const prefix string = ",\"primaryExch\":"
+		out.RawString(prefix)
+		out.String(string(in.PrimaryExch))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.String(string(in.Updated))
+	}
+	if in.Codes != nil {
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix)
+		if *in.Codes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawByte('[')
-			for v36, v37 := range in.Results {
-				if v36 > 0 {
+			out.RawByte('{')
+			v33First := true
+			for v33Name, v33Value := range *in.Codes {
+				if v33First {
+					v33First = false
+				} else {
 					out.RawByte(',')
 				}
-				(v37).MarshalEasyJSON(out)
+				out.String(string(v33Name))
+				out.RawByte(':')
+				out.String(string(v33Value))
 			}
-			out.RawByte(']')
+			out.RawByte('}')
+		}
+	}
+	if in.Attrs != nil {
+		const prefix string = ",\"attrs\":"
+		out.RawString(prefix)
+		if *in.Attrs == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v34First := true
+			for v34Name, v34Value := range *in.Attrs {
+				if v34First {
+					v34First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v34Name))
+				out.RawByte(':')
+				if m, ok := v34Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v34Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v34Value))
+				}
+			}
+			out.RawByte('}')
 		}
 	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StockTradesResponse) MarshalJSON() ([]byte, error) {
+func (v Ticker) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StockTradesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(w, v)
+func (v Ticker) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StockTradesResponse) UnmarshalJSON(data []byte) error {
+func (v *Ticker) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StockTradesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(l, v)
+func (v *Ticker) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient10(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(in *jlexer.Lexer, out *StockSnapshotsResponse) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(in *jlexer.Lexer, out *StreamingServerMsges) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
 		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "tickers":
-			if in.IsNull() {
-				in.Skip()
-				out.Results = nil
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamingServerMsges, 0, 0)
 			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make(Snapshots, 0, 0)
-					} else {
-						out.Results = Snapshots{}
-					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v38 Snapshot
-					(v38).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v38)
-					in.WantComma()
-				}
-				in.Delim(']')
+				*out = StreamingServerMsges{}
 			}
-		default:
-			in.SkipRecursive()
+		} else {
+			*out = (*out)[:0]
 		}
-		in.WantComma()
+		for !in.IsDelim(']') {
+			var v35 StreamingServerMsg
+			(v35).UnmarshalEasyJSON(in)
+			*out = append(*out, v35)
+			in.WantComma()
+		}
+		in.Delim(']')
 	}
-	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(out *jwriter.Writer, in StockSnapshotsResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"tickers\":"
-		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v39, v40 := range in.Results {
-				if v39 > 0 {
-					out.RawByte(',')
-				}
-				(v40).MarshalEasyJSON(out)
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(out *jwriter.Writer, in StreamingServerMsges) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v36, v37 := range in {
+			if v36 > 0 {
+				out.RawByte(',')
 			}
-			out.RawByte(']')
+			(v37).MarshalEasyJSON(out)
 		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StockSnapshotsResponse) MarshalJSON() ([]byte, error) {
+func (v StreamingServerMsges) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StockSnapshotsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(w, v)
+func (v StreamingServerMsges) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StockSnapshotsResponse) UnmarshalJSON(data []byte) error {
+func (v *StreamingServerMsges) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StockSnapshotsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(l, v)
+func (v *StreamingServerMsges) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient11(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(in *jlexer.Lexer, out *StockQuotesResponse) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(in *jlexer.Lexer, out *StreamingServerMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2218,29 +1946,3211 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "results":
-			if in.IsNull() {
-				in.Skip()
-				out.Results = nil
+		case "ev":
+			out.Event = string(in.String())
+		case "sym":
+			out.Symbol = string(in.String())
+		case "x":
+			out.Exchange = int32(in.Int32())
+		case "i":
+			out.TradeID = string(in.String())
+		case "p":
+			out.Price = float32(in.Float32())
+		case "s":
+			out.S = int64(in.Int64())
+		case "c":
+			if m, ok := out.C.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.C.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make(Quotes, 0, 0)
-					} else {
-						out.Results = Quotes{}
-					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v41 Quote
-					(v41).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v41)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.C = in.Interface()
+			}
+		case "t":
+			out.Timestamp = int64(in.Int64())
+		case "z":
+			out.Trade = int64(in.Int64())
+		case "bx":
+			out.BidExchange = int32(in.Int32())
+		case "ax":
+			out.AskExchange = int32(in.Int32())
+		case "bp":
+			out.BidPrice = float32(in.Float32())
+		case "ap":
+			out.AskPrice = float32(in.Float32())
+		case "bs":
+			out.BidSize = int32(in.Int32())
+		case "as":
+			out.AskSize = int32(in.Int32())
+		case "v":
+			out.Volume = int32(in.Int32())
+		case "av":
+			out.AccumulatedVolume = int64(in.Int64())
+		case "op":
+			out.OpeningPrice = float32(in.Float32())
+		case "vw":
+			out.VWAP = float32(in.Float32())
+		case "o":
+			out.OpenPrice = float32(in.Float32())
+		case "h":
+			out.HighPrice = float32(in.Float32())
+		case "l":
+			out.LowPrice = float32(in.Float32())
+		case "a":
+			out.Average = float32(in.Float32())
+		case "e":
+			out.EndTimestamp = int64(in.Int64())
+		case "T":
+			out.Ticker = string(in.String())
+		case "val":
+			out.Value = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(out *jwriter.Writer, in StreamingServerMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"sym\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Exchange))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.TradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Price))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.S))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		if m, ok := in.C.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.C.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.C))
+		}
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Trade))
+	}
+	{
+		const prefix string = ",\"bx\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidExchange))
+	}
+	{
+		const prefix string = ",\"ax\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskExchange))
+	}
+	{
+		const prefix string = ",\"bp\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.BidPrice))
+	}
+	{
+		const prefix string = ",\"ap\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.AskPrice))
+	}
+	{
+		const prefix string = ",\"bs\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidSize))
+	}
+	{
+		const prefix string = ",\"as\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskSize))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Volume))
+	}
+	{
+		const prefix string = ",\"av\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AccumulatedVolume))
+	}
+	{
+		const prefix string = ",\"op\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OpeningPrice))
+	}
+	{
+		const prefix string = ",\"vw\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.VWAP))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OpenPrice))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.HighPrice))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.LowPrice))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Average))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTimestamp))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"val\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamingServerMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamingServerMsg) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamingServerMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamingServerMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient12(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(in *jlexer.Lexer, out *StreamTrades) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamTrades, 0, 0)
+			} else {
+				*out = StreamTrades{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v38 StreamTrade
+			(v38).UnmarshalEasyJSON(in)
+			*out = append(*out, v38)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(out *jwriter.Writer, in StreamTrades) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v39, v40 := range in {
+			if v39 > 0 {
+				out.RawByte(',')
+			}
+			(v40).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamTrades) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamTrades) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamTrades) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamTrades) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient13(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(in *jlexer.Lexer, out *StreamTrade) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "sym":
+			out.Symbol = string(in.String())
+		case "x":
+			out.Exchange = int32(in.Int32())
+		case "i":
+			out.TradeID = string(in.String())
+		case "p":
+			out.Price = float32(in.Float32())
+		case "s":
+			out.Size = int32(in.Int32())
+		case "t":
+			out.Timestamp = int64(in.Int64())
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+				out.Conditions = nil
+			} else {
+				in.Delim('[')
+				if out.Conditions == nil {
+					if !in.IsDelim(']') {
+						out.Conditions = make([]int32, 0, 16)
+					} else {
+						out.Conditions = []int32{}
+					}
+				} else {
+					out.Conditions = (out.Conditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v41 int32
+					v41 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v41)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "z":
+			out.Unknown = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(out *jwriter.Writer, in StreamTrade) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"sym\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Exchange))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.TradeID))
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Price))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Size))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Conditions {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v43))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Unknown))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamTrade) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamTrade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamTrade) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient14(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(in *jlexer.Lexer, out *StreamQuotes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamQuotes, 0, 0)
+			} else {
+				*out = StreamQuotes{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v44 StreamQuote
+			(v44).UnmarshalEasyJSON(in)
+			*out = append(*out, v44)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(out *jwriter.Writer, in StreamQuotes) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v45, v46 := range in {
+			if v45 > 0 {
+				out.RawByte(',')
+			}
+			(v46).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamQuotes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamQuotes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamQuotes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamQuotes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(in *jlexer.Lexer, out *StreamQuote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "sym":
+			out.Symbol = string(in.String())
+		case "c":
+			out.Condition = int32(in.Int32())
+		case "bx":
+			out.BidExchange = int32(in.Int32())
+		case "ax":
+			out.AskExchange = int32(in.Int32())
+		case "bp":
+			out.BidPrice = float32(in.Float32())
+		case "ap":
+			out.AskPrice = float32(in.Float32())
+		case "bs":
+			out.BidSize = int32(in.Int32())
+		case "as":
+			out.AskSize = int32(in.Int32())
+		case "t":
+			out.Timestamp = int64(in.Int64())
+		case "z":
+			out.Unknown = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(out *jwriter.Writer, in StreamQuote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"sym\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Condition))
+	}
+	{
+		const prefix string = ",\"bx\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidExchange))
+	}
+	{
+		const prefix string = ",\"ax\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskExchange))
+	}
+	{
+		const prefix string = ",\"bp\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.BidPrice))
+	}
+	{
+		const prefix string = ",\"ap\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.AskPrice))
+	}
+	{
+		const prefix string = ",\"bs\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidSize))
+	}
+	{
+		const prefix string = ",\"as\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskSize))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Unknown))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamQuote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamQuote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamQuote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(in *jlexer.Lexer, out *StreamIndexValues) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamIndexValues, 0, 1)
+			} else {
+				*out = StreamIndexValues{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v47 StreamIndexValue
+			(v47).UnmarshalEasyJSON(in)
+			*out = append(*out, v47)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(out *jwriter.Writer, in StreamIndexValues) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v48, v49 := range in {
+			if v48 > 0 {
+				out.RawByte(',')
+			}
+			(v49).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamIndexValues) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamIndexValues) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamIndexValues) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamIndexValues) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(in *jlexer.Lexer, out *StreamIndexValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "T":
+			out.Ticker = string(in.String())
+		case "val":
+			out.Value = float64(in.Float64())
+		case "t":
+			out.Timestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(out *jwriter.Writer, in StreamIndexValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"val\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Value))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamIndexValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamIndexValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamIndexValue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamIndexValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(in *jlexer.Lexer, out *StreamIndexAggregates) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamIndexAggregates, 0, 0)
+			} else {
+				*out = StreamIndexAggregates{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v50 StreamIndexAggregate
+			(v50).UnmarshalEasyJSON(in)
+			*out = append(*out, v50)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(out *jwriter.Writer, in StreamIndexAggregates) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v51, v52 := range in {
+			if v51 > 0 {
+				out.RawByte(',')
+			}
+			(v52).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamIndexAggregates) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamIndexAggregates) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamIndexAggregates) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamIndexAggregates) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(in *jlexer.Lexer, out *StreamIndexAggregate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "sym":
+			out.Symbol = string(in.String())
+		case "op":
+			out.OpeningPrice = float64(in.Float64())
+		case "o":
+			out.OpenPrice = float64(in.Float64())
+		case "c":
+			out.ClosePrice = float64(in.Float64())
+		case "h":
+			out.HighPrice = float64(in.Float64())
+		case "l":
+			out.LowPrice = float64(in.Float64())
+		case "s":
+			out.StartTimestamp = int64(in.Int64())
+		case "e":
+			out.EndTimestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(out *jwriter.Writer, in StreamIndexAggregate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"sym\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"op\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.OpeningPrice))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.OpenPrice))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ClosePrice))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.HighPrice))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.LowPrice))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.StartTimestamp))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTimestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamIndexAggregate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamIndexAggregate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamIndexAggregate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamIndexAggregate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(in *jlexer.Lexer, out *StreamAggregates) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(StreamAggregates, 0, 0)
+			} else {
+				*out = StreamAggregates{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v53 StreamAggregate
+			(v53).UnmarshalEasyJSON(in)
+			*out = append(*out, v53)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(out *jwriter.Writer, in StreamAggregates) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v54, v55 := range in {
+			if v54 > 0 {
+				out.RawByte(',')
+			}
+			(v55).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamAggregates) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamAggregates) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamAggregates) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamAggregates) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(in *jlexer.Lexer, out *StreamAggregate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "sym":
+			out.Symbol = string(in.String())
+		case "v":
+			out.Volume = int32(in.Int32())
+		case "av":
+			out.AccumulatedVolume = int64(in.Int64())
+		case "op":
+			out.OpeningPrice = float32(in.Float32())
+		case "vw":
+			out.VWAP = float32(in.Float32())
+		case "o":
+			out.OpenPrice = float32(in.Float32())
+		case "c":
+			out.ClosePrice = float32(in.Float32())
+		case "h":
+			out.HighPrice = float32(in.Float32())
+		case "l":
+			out.LowPrice = float32(in.Float32())
+		case "a":
+			out.Average = float32(in.Float32())
+		case "TotalTrade":
+			out.TotalTrade = int32(in.Int32())
+		case "s":
+			out.StartTimestamp = int64(in.Int64())
+		case "e":
+			out.EndTimestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(out *jwriter.Writer, in StreamAggregate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"sym\":"
+		out.RawString(prefix)
+		out.String(string(in.Symbol))
+	}
+	{
+		const prefix string = ",\"v\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Volume))
+	}
+	{
+		const prefix string = ",\"av\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AccumulatedVolume))
+	}
+	{
+		const prefix string = ",\"op\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OpeningPrice))
+	}
+	{
+		const prefix string = ",\"vw\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.VWAP))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OpenPrice))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.ClosePrice))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.HighPrice))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.LowPrice))
+	}
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Average))
+	}
+	{
+		const prefix string = ",\"TotalTrade\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.TotalTrade))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.StartTimestamp))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EndTimestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamAggregate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamAggregate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamAggregate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamAggregate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(in *jlexer.Lexer, out *StockTradesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(Trades, 0, 0)
+					} else {
+						out.Results = Trades{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 Trade
+					(v56).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(out *jwriter.Writer, in StockTradesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Results {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				(v58).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StockTradesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StockTradesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StockTradesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StockTradesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(in *jlexer.Lexer, out *StockSnapshotsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tickers":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(Snapshots, 0, 0)
+					} else {
+						out.Results = Snapshots{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 Snapshot
+					(v59).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(out *jwriter.Writer, in StockSnapshotsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tickers\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v60, v61 := range in.Results {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				(v61).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StockSnapshotsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StockSnapshotsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StockSnapshotsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StockSnapshotsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(in *jlexer.Lexer, out *StockQuotesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(Quotes, 0, 0)
+					} else {
+						out.Results = Quotes{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v62 Quote
+					(v62).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v62)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(out *jwriter.Writer, in StockQuotesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v63, v64 := range in.Results {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				(v64).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StockQuotesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StockQuotesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StockQuotesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StockQuotesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(in *jlexer.Lexer, out *StockBarsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "queryCount":
+			out.QueryCount = int32(in.Int32())
+		case "resultsCount":
+			out.ResultsCount = int32(in.Int32())
+		case "results":
+			(out.Results).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(out *jwriter.Writer, in StockBarsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"queryCount\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.QueryCount))
+	}
+	{
+		const prefix string = ",\"resultsCount\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ResultsCount))
+	}
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix)
+		(in.Results).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StockBarsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StockBarsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StockBarsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StockBarsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(in *jlexer.Lexer, out *Split) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "exDate":
+			out.ExDate = string(in.String())
+		case "paymentDate":
+			out.PaymentDate = string(in.String())
+		case "recordDate":
+			out.RecorDate = string(in.String())
+		case "declaredDate":
+			out.DeclearedDate = string(in.String())
+		case "ratio":
+			out.Ratio = float32(in.Float32())
+		case "tofactor":
+			out.ToFactor = int32(in.Int32())
+		case "forfactor":
+			out.ForFactor = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(out *jwriter.Writer, in Split) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"exDate\":"
+		out.RawString(prefix)
+		out.String(string(in.ExDate))
+	}
+	{
+		const prefix string = ",\"paymentDate\":"
+		out.RawString(prefix)
+		out.String(string(in.PaymentDate))
+	}
+	{
+		const prefix string = ",\"recordDate\":"
+		out.RawString(prefix)
+		out.String(string(in.RecorDate))
+	}
+	{
+		const prefix string = ",\"declaredDate\":"
+		out.RawString(prefix)
+		out.String(string(in.DeclearedDate))
+	}
+	{
+		const prefix string = ",\"ratio\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Ratio))
+	}
+	{
+		const prefix string = ",\"tofactor\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ToFactor))
+	}
+	{
+		const prefix string = ",\"forfactor\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ForFactor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Split) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Split) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Split) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Split) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(in *jlexer.Lexer, out *Snapshot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "todaysChange":
+			out.TodayChange = float32(in.Float32())
+		case "todaysChangePerc":
+			out.TodayChangePct = float32(in.Float32())
+		case "day":
+			(out.Day).UnmarshalEasyJSON(in)
+		case "prevDay":
+			(out.PrevDay).UnmarshalEasyJSON(in)
+		case "lastQuote":
+			(out.LastQuote).UnmarshalEasyJSON(in)
+		case "lastTrade":
+			(out.LastTrade).UnmarshalEasyJSON(in)
+		case "min":
+			(out.Min).UnmarshalEasyJSON(in)
+		case "updated":
+			out.Updated = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(out *jwriter.Writer, in Snapshot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"todaysChange\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.TodayChange))
+	}
+	{
+		const prefix string = ",\"todaysChangePerc\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.TodayChangePct))
+	}
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		(in.Day).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"prevDay\":"
+		out.RawString(prefix)
+		(in.PrevDay).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastQuote\":"
+		out.RawString(prefix)
+		(in.LastQuote).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastTrade\":"
+		out.RawString(prefix)
+		(in.LastTrade).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		(in.Min).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Updated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Snapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Snapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Snapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Snapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(in *jlexer.Lexer, out *RequestOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Unadjusted":
+			out.Unadjusted = Unadjusted(in.String())
+		case "Sort":
+			out.Sort = Sort(in.String())
+		case "Timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "TimestampLimit":
+			out.TimestampLimit = int64(in.Int64())
+		case "Reverse":
+			out.Reverse = Reverse(in.String())
+		case "Limit":
+			out.Limit = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(out *jwriter.Writer, in RequestOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Unadjusted\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Unadjusted))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	{
+		const prefix string = ",\"Timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"TimestampLimit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TimestampLimit))
+	}
+	{
+		const prefix string = ",\"Reverse\":"
+		out.RawString(prefix)
+		out.String(string(in.Reverse))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(in *jlexer.Lexer, out *Quote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "y":
+			out.ExTime = int64(in.Int64())
+		case "t":
+			out.SIPTime = int64(in.Int64())
+		case "f":
+			out.TRFTime = int64(in.Int64())
+		case "q":
+			out.Sequence = int32(in.Int32())
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+				out.Conditions = nil
+			} else {
+				in.Delim('[')
+				if out.Conditions == nil {
+					if !in.IsDelim(']') {
+						out.Conditions = make([]int32, 0, 16)
+					} else {
+						out.Conditions = []int32{}
+					}
+				} else {
+					out.Conditions = (out.Conditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v65 int32
+					v65 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v65)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "i":
+			if in.IsNull() {
+				in.Skip()
+				out.Indicators = nil
+			} else {
+				in.Delim('[')
+				if out.Indicators == nil {
+					if !in.IsDelim(']') {
+						out.Indicators = make([]int32, 0, 16)
+					} else {
+						out.Indicators = []int32{}
+					}
+				} else {
+					out.Indicators = (out.Indicators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v66 int32
+					v66 = int32(in.Int32())
+					out.Indicators = append(out.Indicators, v66)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "p":
+			out.BidPrice = float64(in.Float64())
+		case "x":
+			out.BidExchange = int32(in.Int32())
+		case "s":
+			out.BidSize = int32(in.Int32())
+		case "P":
+			out.AskPrice = float64(in.Float64())
+		case "X":
+			out.AskExchange = int32(in.Int32())
+		case "S":
+			out.AskSize = int32(in.Int32())
+		case "z":
+			out.ListedEx = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(out *jwriter.Writer, in Quote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"y\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ExTime))
+	}
+	{
+		const prefix string = ",\"t\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SIPTime))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TRFTime))
+	}
+	{
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Sequence))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v67, v68 := range in.Conditions {
+				if v67 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v68))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		if in.Indicators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v69, v70 := range in.Indicators {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v70))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BidPrice))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidExchange))
+	}
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.BidSize))
+	}
+	{
+		const prefix string = ",\"P\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AskPrice))
+	}
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskExchange))
+	}
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.AskSize))
+	}
+	{
+		const prefix string = ",\"z\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ListedEx))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Quote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Quote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Quote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Quote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(in *jlexer.Lexer, out *PolygonClientMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "params":
+			out.Params = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(out *jwriter.Writer, in PolygonClientMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		out.String(string(in.Params))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolygonClientMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PolygonClientMsg) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolygonClientMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PolygonClientMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(in *jlexer.Lexer, out *PolygonAuthMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ev":
+			out.Event = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(out *jwriter.Writer, in PolygonAuthMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ev\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolygonAuthMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PolygonAuthMsg) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolygonAuthMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PolygonAuthMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(in *jlexer.Lexer, out *OptionsChainOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ContractType":
+			out.ContractType = OptionType(in.String())
+		case "ExpirationDate":
+			out.ExpirationDate = string(in.String())
+		case "StrikePrice":
+			out.StrikePrice = float64(in.Float64())
+		case "Order":
+			out.Order = Sort(in.String())
+		case "Limit":
+			out.Limit = int32(in.Int32())
+		case "Sort":
+			out.Sort = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(out *jwriter.Writer, in OptionsChainOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ContractType\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ContractType))
+	}
+	{
+		const prefix string = ",\"ExpirationDate\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpirationDate))
+	}
+	{
+		const prefix string = ",\"StrikePrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.StrikePrice))
+	}
+	{
+		const prefix string = ",\"Order\":"
+		out.RawString(prefix)
+		out.String(string(in.Order))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Limit))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionsChainOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionsChainOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionsChainOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionsChainOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(in *jlexer.Lexer, out *OptionSnapshot) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "details":
+			(out.Details).UnmarshalEasyJSON(in)
+		case "day":
+			(out.Day).UnmarshalEasyJSON(in)
+		case "last_quote":
+			(out.LastQuote).UnmarshalEasyJSON(in)
+		case "greeks":
+			(out.Greeks).UnmarshalEasyJSON(in)
+		case "implied_volatility":
+			out.ImpliedVolatility = float64(in.Float64())
+		case "open_interest":
+			out.OpenInterest = float64(in.Float64())
+		case "break_even_price":
+			out.BreakEvenPrice = float64(in.Float64())
+		case "underlying_asset":
+			(out.UnderlyingAsset).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(out *jwriter.Writer, in OptionSnapshot) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"details\":"
+		out.RawString(prefix[1:])
+		(in.Details).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		(in.Day).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"last_quote\":"
+		out.RawString(prefix)
+		(in.LastQuote).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"greeks\":"
+		out.RawString(prefix)
+		(in.Greeks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"implied_volatility\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ImpliedVolatility))
+	}
+	{
+		const prefix string = ",\"open_interest\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.OpenInterest))
+	}
+	{
+		const prefix string = ",\"break_even_price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BreakEvenPrice))
+	}
+	{
+		const prefix string = ",\"underlying_asset\":"
+		out.RawString(prefix)
+		(in.UnderlyingAsset).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionSnapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionSnapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(in *jlexer.Lexer, out *OptionLastQuote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bid":
+			out.Bid = float64(in.Float64())
+		case "bid_size":
+			out.BidSize = float64(in.Float64())
+		case "ask":
+			out.Ask = float64(in.Float64())
+		case "ask_size":
+			out.AskSize = float64(in.Float64())
+		case "midpoint":
+			out.Midpoint = float64(in.Float64())
+		case "last_updated":
+			out.LastUpdated = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(out *jwriter.Writer, in OptionLastQuote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bid\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"bid_size\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BidSize))
+	}
+	{
+		const prefix string = ",\"ask\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Ask))
+	}
+	{
+		const prefix string = ",\"ask_size\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AskSize))
+	}
+	{
+		const prefix string = ",\"midpoint\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Midpoint))
+	}
+	{
+		const prefix string = ",\"last_updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionLastQuote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionLastQuote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionLastQuote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionLastQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(in *jlexer.Lexer, out *OptionGreeks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "delta":
+			out.Delta = float64(in.Float64())
+		case "gamma":
+			out.Gamma = float64(in.Float64())
+		case "theta":
+			out.Theta = float64(in.Float64())
+		case "vega":
+			out.Vega = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(out *jwriter.Writer, in OptionGreeks) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"delta\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Delta))
+	}
+	{
+		const prefix string = ",\"gamma\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Gamma))
+	}
+	{
+		const prefix string = ",\"theta\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Theta))
+	}
+	{
+		const prefix string = ",\"vega\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Vega))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionGreeks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionGreeks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionGreeks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionGreeks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(in *jlexer.Lexer, out *OptionDay) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "open":
+			out.Open = float64(in.Float64())
+		case "high":
+			out.High = float64(in.Float64())
+		case "low":
+			out.Low = float64(in.Float64())
+		case "close":
+			out.Close = float64(in.Float64())
+		case "volume":
+			out.Volume = float64(in.Float64())
+		case "vwap":
+			out.VWAP = float64(in.Float64())
+		case "change":
+			out.Change = float64(in.Float64())
+		case "change_percent":
+			out.ChangePercent = float64(in.Float64())
+		case "previous_close":
+			out.PreviousClose = float64(in.Float64())
+		case "last_updated":
+			out.LastUpdated = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(out *jwriter.Writer, in OptionDay) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Open))
+	}
+	{
+		const prefix string = ",\"high\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.High))
+	}
+	{
+		const prefix string = ",\"low\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Low))
+	}
+	{
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Close))
+	}
+	{
+		const prefix string = ",\"volume\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Volume))
+	}
+	{
+		const prefix string = ",\"vwap\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.VWAP))
+	}
+	{
+		const prefix string = ",\"change\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Change))
+	}
+	{
+		const prefix string = ",\"change_percent\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ChangePercent))
+	}
+	{
+		const prefix string = ",\"previous_close\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.PreviousClose))
+	}
+	{
+		const prefix string = ",\"last_updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionDay) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionDay) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionDay) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionDay) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(in *jlexer.Lexer, out *OptionContract) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "underlying_ticker":
+			out.UnderlyingTicker = string(in.String())
+		case "contract_type":
+			out.ContractType = OptionType(in.String())
+		case "exercise_style":
+			out.ExerciseStyle = string(in.String())
+		case "expiration_date":
+			out.ExpirationDate = string(in.String())
+		case "strike_price":
+			out.StrikePrice = float64(in.Float64())
+		case "shares_per_contract":
+			out.SharesPerContract = float64(in.Float64())
+		case "primary_exchange":
+			out.PrimaryExchange = string(in.String())
+		case "cfi":
+			out.CFI = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(out *jwriter.Writer, in OptionContract) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
+	}
+	{
+		const prefix string = ",\"underlying_ticker\":"
+		out.RawString(prefix)
+		out.String(string(in.UnderlyingTicker))
+	}
+	{
+		const prefix string = ",\"contract_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContractType))
+	}
+	{
+		const prefix string = ",\"exercise_style\":"
+		out.RawString(prefix)
+		out.String(string(in.ExerciseStyle))
+	}
+	{
+		const prefix string = ",\"expiration_date\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpirationDate))
+	}
+	{
+		const prefix string = ",\"strike_price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.StrikePrice))
+	}
+	{
+		const prefix string = ",\"shares_per_contract\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.SharesPerContract))
+	}
+	{
+		const prefix string = ",\"primary_exchange\":"
+		out.RawString(prefix)
+		out.String(string(in.PrimaryExchange))
+	}
+	{
+		const prefix string = ",\"cfi\":"
+		out.RawString(prefix)
+		out.String(string(in.CFI))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptionContract) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptionContract) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptionContract) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptionContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(in *jlexer.Lexer, out *NewsOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "PerPage":
+			out.PerPage = int32(in.Int32())
+		case "Page":
+			out.Page = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(out *jwriter.Writer, in NewsOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"PerPage\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.PerPage))
+	}
+	{
+		const prefix string = ",\"Page\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Page))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NewsOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NewsOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NewsOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NewsOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient40(in *jlexer.Lexer, out *MarketStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "market":
+			out.Market = string(in.String())
+		case "serverTime":
+			out.ServerTime = string(in.String())
+		case "exchanges":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Exchanges = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v71 string
+					v71 = string(in.String())
+					(out.Exchanges)[key] = v71
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "currencies":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Currencies = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v72 string
+					v72 = string(in.String())
+					(out.Currencies)[key] = v72
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient40(out *jwriter.Writer, in MarketStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"market\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Market))
+	}
+	{
+		const prefix string = ",\"serverTime\":"
+		out.RawString(prefix)
+		out.String(string(in.ServerTime))
+	}
+	{
+		const prefix string = ",\"exchanges\":"
+		out.RawString(prefix)
+		if in.Exchanges == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v73First := true
+			for v73Name, v73Value := range in.Exchanges {
+				if v73First {
+					v73First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v73Name))
+				out.RawByte(':')
+				out.String(string(v73Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"currencies\":"
+		out.RawString(prefix)
+		if in.Currencies == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v74First := true
+			for v74Name, v74Value := range in.Currencies {
+				if v74First {
+					v74First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v74Name))
+				out.RawByte(':')
+				out.String(string(v74Value))
 			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarketStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarketStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarketStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarketStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient40(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient41(in *jlexer.Lexer, out *MarketHoliday) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "exchange":
+			out.Exchange = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "date":
+			out.Date = string(in.String())
+		case "open":
+			out.Open = string(in.String())
+		case "close":
+			out.Close = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2251,53 +5161,67 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(out *jwriter.Writer, in StockQuotesResponse) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient41(out *jwriter.Writer, in MarketHoliday) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v42, v43 := range in.Results {
-				if v42 > 0 {
-					out.RawByte(',')
-				}
-				(v43).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Exchange))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.String(string(in.Date))
+	}
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StockQuotesResponse) MarshalJSON() ([]byte, error) {
+func (v MarketHoliday) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StockQuotesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient15(w, v)
+func (v MarketHoliday) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StockQuotesResponse) UnmarshalJSON(data []byte) error {
+func (v *MarketHoliday) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StockQuotesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient15(l, v)
+func (v *MarketHoliday) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient41(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(in *jlexer.Lexer, out *StockBarsResponse) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient42(in *jlexer.Lexer, out *MarketDescription) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2316,8 +5240,10 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "results":
-			(out.Results).UnmarshalEasyJSON(in)
+		case "market":
+			out.Name = Market(in.String())
+		case "desc":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2328,42 +5254,47 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(out *jwriter.Writer, in StockBarsResponse) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient42(out *jwriter.Writer, in MarketDescription) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"market\":"
 		out.RawString(prefix[1:])
-		(in.Results).MarshalEasyJSON(out)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"desc\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StockBarsResponse) MarshalJSON() ([]byte, error) {
+func (v MarketDescription) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StockBarsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient16(w, v)
+func (v MarketDescription) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StockBarsResponse) UnmarshalJSON(data []byte) error {
+func (v *MarketDescription) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StockBarsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient16(l, v)
+func (v *MarketDescription) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient42(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(in *jlexer.Lexer, out *Split) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient43(in *jlexer.Lexer, out *LocaleName) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2382,22 +5313,10 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "ticker":
-			out.Ticker = string(in.String())
-		case "exDate":
-			out.ExDate = string(in.String())
-		case "paymentDate":
-			out.PaymentDate = string(in.String())
-		case "recordDate":
-			out.RecorDate = string(in.String())
-		case "declaredDate":
-			out.DeclearedDate = string(in.String())
-		case "ratio":
-			out.Ratio = float32(in.Float32())
-		case "tofactor":
-			out.ToFactor = int32(in.Int32())
-		case "forfactor":
-			out.ForFactor = int32(in.Int32())
+		case "locale":
+			out.Locale = Locale(in.String())
+		case "name":
+			out.Name = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2408,77 +5327,141 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(out *jwriter.Writer, in Split) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient43(out *jwriter.Writer, in LocaleName) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ticker\":"
+		const prefix string = ",\"locale\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Ticker))
+		out.String(string(in.Locale))
 	}
 	{
-		const prefix string = ",\"exDate\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.ExDate))
+		out.String(string(in.Name))
 	}
-	{
-		const prefix string = ",\"paymentDate\":"
-		out.RawString(prefix)
-		out.String(string(in.PaymentDate))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LocaleName) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LocaleName) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LocaleName) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LocaleName) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient43(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient44(in *jlexer.Lexer, out *LastTrade) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cond1":
+			out.Condition1 = int32(in.Int32())
+		case "exchange":
+			out.Exchange = int32(in.Int32())
+		case "float64":
+			out.Price = float64(in.Float64())
+		case "size":
+			out.Size = int32(in.Int32())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient44(out *jwriter.Writer, in LastTrade) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"recordDate\":"
-		out.RawString(prefix)
-		out.String(string(in.RecorDate))
+		const prefix string = ",\"cond1\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Condition1))
 	}
 	{
-		const prefix string = ",\"declaredDate\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
-		out.String(string(in.DeclearedDate))
+		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"ratio\":"
+		const prefix string = ",\"float64\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Ratio))
+		out.Float64(float64(in.Price))
 	}
 	{
-		const prefix string = ",\"tofactor\":"
+		const prefix string = ",\"size\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ToFactor))
+		out.Int32(int32(in.Size))
 	}
 	{
-		const prefix string = ",\"forfactor\":"
+		const prefix string = ",\"timestamp\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ForFactor))
+		out.Int64(int64(in.Timestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Split) MarshalJSON() ([]byte, error) {
+func (v LastTrade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Split) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient17(w, v)
+func (v LastTrade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Split) UnmarshalJSON(data []byte) error {
+func (v *LastTrade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Split) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient17(l, v)
+func (v *LastTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient44(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(in *jlexer.Lexer, out *Snapshot) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient45(in *jlexer.Lexer, out *LastQuote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2497,24 +5480,20 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "ticker":
-			out.Ticker = string(in.String())
-		case "todaysChange":
-			out.TodayChange = float32(in.Float32())
-		case "todaysChangePerc":
-			out.TodayChangePct = float32(in.Float32())
-		case "day":
-			(out.Day).UnmarshalEasyJSON(in)
-		case "prevDay":
-			(out.PrevDay).UnmarshalEasyJSON(in)
-		case "lastQuote":
-			(out.LastQuote).UnmarshalEasyJSON(in)
-		case "lastTrade":
-			(out.LastTrade).UnmarshalEasyJSON(in)
-		case "min":
-			(out.Min).UnmarshalEasyJSON(in)
-		case "updated":
-			out.Updated = int64(in.Int64())
+		case "bidprice":
+			out.BidPrice = float64(in.Float64())
+		case "bidexchange":
+			out.BidExchange = int32(in.Int32())
+		case "bidsize":
+			out.BidSize = int32(in.Int32())
+		case "askprice":
+			out.AskPrice = float64(in.Float64())
+		case "askexchange":
+			out.AskExchange = int32(in.Int32())
+		case "asksize":
+			out.AskSize = int32(in.Int32())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2525,82 +5504,72 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(out *jwriter.Writer, in Snapshot) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient45(out *jwriter.Writer, in LastQuote) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ticker\":"
+		const prefix string = ",\"bidprice\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Ticker))
-	}
-	{
-		const prefix string = ",\"todaysChange\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.TodayChange))
-	}
-	{
-		const prefix string = ",\"todaysChangePerc\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.TodayChangePct))
+		out.Float64(float64(in.BidPrice))
 	}
 	{
-		const prefix string = ",\"day\":"
+		const prefix string = ",\"bidexchange\":"
 		out.RawString(prefix)
-		(in.Day).MarshalEasyJSON(out)
+		out.Int32(int32(in.BidExchange))
 	}
 	{
-		const prefix string = ",\"prevDay\":"
+		const prefix string = ",\"bidsize\":"
 		out.RawString(prefix)
-		(in.PrevDay).MarshalEasyJSON(out)
+		out.Int32(int32(in.BidSize))
 	}
 	{
-		const prefix string = ",\"lastQuote\":"
+		const prefix string = ",\"askprice\":"
 		out.RawString(prefix)
-		(in.LastQuote).MarshalEasyJSON(out)
+		out.Float64(float64(in.AskPrice))
 	}
 	{
-		const prefix string = ",\"lastTrade\":"
+		const prefix string = ",\"askexchange\":"
 		out.RawString(prefix)
-		(in.LastTrade).MarshalEasyJSON(out)
+		out.Int32(int32(in.AskExchange))
 	}
 	{
-		const prefix string = ",\"min\":"
+		const prefix string = ",\"asksize\":"
 		out.RawString(prefix)
-		(in.Min).MarshalEasyJSON(out)
+		out.Int32(int32(in.AskSize))
 	}
 	{
-		const prefix string = ",\"updated\":"
+		const prefix string = ",\"timestamp\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Updated))
+		out.Int64(int64(in.Timestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Snapshot) MarshalJSON() ([]byte, error) {
+func (v LastQuote) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Snapshot) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient18(w, v)
+func (v LastQuote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Snapshot) UnmarshalJSON(data []byte) error {
+func (v *LastQuote) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Snapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient18(l, v)
+func (v *LastQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient45(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(in *jlexer.Lexer, out *RequestOptions) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient46(in *jlexer.Lexer, out *IndexSnapshotsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2619,18 +5588,29 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "Unadjusted":
-			out.Unadjusted = Unadjusted(in.String())
-		case "Sort":
-			out.Sort = Sort(in.String())
-		case "Timestamp":
-			out.Timestamp = int64(in.Int64())
-		case "TimestampLimit":
-			out.TimestampLimit = int64(in.Int64())
-		case "Reverse":
-			out.Reverse = Reverse(in.String())
-		case "Limit":
-			out.Limit = int64(in.Int64())
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(IndexSnapshots, 0, 0)
+					} else {
+						out.Results = IndexSnapshots{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 IndexSnapshot
+					(v75).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2641,67 +5621,53 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(out *jwriter.Writer, in RequestOptions) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient46(out *jwriter.Writer, in IndexSnapshotsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Unadjusted\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Unadjusted))
-	}
-	{
-		const prefix string = ",\"Sort\":"
-		out.RawString(prefix)
-		out.String(string(in.Sort))
-	}
-	{
-		const prefix string = ",\"Timestamp\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
-	}
-	{
-		const prefix string = ",\"TimestampLimit\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.TimestampLimit))
-	}
-	{
-		const prefix string = ",\"Reverse\":"
-		out.RawString(prefix)
-		out.String(string(in.Reverse))
-	}
-	{
-		const prefix string = ",\"Limit\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v76, v77 := range in.Results {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				(v77).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RequestOptions) MarshalJSON() ([]byte, error) {
+func (v IndexSnapshotsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RequestOptions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient19(w, v)
+func (v IndexSnapshotsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RequestOptions) UnmarshalJSON(data []byte) error {
+func (v *IndexSnapshotsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RequestOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient19(l, v)
+func (v *IndexSnapshotsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient46(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(in *jlexer.Lexer, out *Quote) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient47(in *jlexer.Lexer, out *IndexSnapshot) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2720,74 +5686,26 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "y":
-			out.ExTime = int64(in.Int64())
-		case "t":
-			out.SIPTime = int64(in.Int64())
-		case "f":
-			out.TRFTime = int64(in.Int64())
-		case "q":
-			out.Sequence = int32(in.Int32())
-		case "c":
-			if in.IsNull() {
-				in.Skip()
-				out.Conditions = nil
-			} else {
-				in.Delim('[')
-				if out.Conditions == nil {
-					if !in.IsDelim(']') {
-						out.Conditions = make([]int32, 0, 16)
-					} else {
-						out.Conditions = []int32{}
-					}
-				} else {
-					out.Conditions = (out.Conditions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v44 int32
-					v44 = int32(in.Int32())
-					out.Conditions = append(out.Conditions, v44)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "i":
-			if in.IsNull() {
-				in.Skip()
-				out.Indicators = nil
-			} else {
-				in.Delim('[')
-				if out.Indicators == nil {
-					if !in.IsDelim(']') {
-						out.Indicators = make([]int32, 0, 16)
-					} else {
-						out.Indicators = []int32{}
-					}
-				} else {
-					out.Indicators = (out.Indicators)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v45 int32
-					v45 = int32(in.Int32())
-					out.Indicators = append(out.Indicators, v45)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "p":
-			out.BidPrice = float64(in.Float64())
-		case "x":
-			out.BidExchange = int32(in.Int32())
-		case "s":
-			out.BidSize = int32(in.Int32())
-		case "P":
-			out.AskPrice = float64(in.Float64())
-		case "X":
-			out.AskExchange = int32(in.Int32())
-		case "S":
-			out.AskSize = int32(in.Int32())
-		case "z":
-			out.ListedEx = int32(in.Int32())
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "value":
+			out.Value = float64(in.Float64())
+		case "type":
+			out.Type = string(in.String())
+		case "market_status":
+			out.MarketStatus = string(in.String())
+		case "session":
+			(out.Session).UnmarshalEasyJSON(in)
+		case "last_updated":
+			out.LastUpdated = int64(in.Int64())
+		case "timeframe":
+			out.Timeframe = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "message":
+			out.Message = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2798,124 +5716,195 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(out *jwriter.Writer, in Quote) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient47(out *jwriter.Writer, in IndexSnapshot) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"y\":"
+		const prefix string = ",\"ticker\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ExTime))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.SIPTime))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"f\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.TRFTime))
+		out.Float64(float64(in.Value))
 	}
 	{
-		const prefix string = ",\"q\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Sequence))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"market_status\":"
 		out.RawString(prefix)
-		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v46, v47 := range in.Conditions {
-				if v46 > 0 {
-					out.RawByte(',')
-				}
-				out.Int32(int32(v47))
-			}
-			out.RawByte(']')
+		out.String(string(in.MarketStatus))
+	}
+	{
+		const prefix string = ",\"session\":"
+		out.RawString(prefix)
+		(in.Session).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"last_updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastUpdated))
+	}
+	{
+		const prefix string = ",\"timeframe\":"
+		out.RawString(prefix)
+		out.String(string(in.Timeframe))
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IndexSnapshot) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IndexSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IndexSnapshot) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IndexSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient47(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient48(in *jlexer.Lexer, out *IndexSession) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "change":
+			out.Change = float64(in.Float64())
+		case "change_percent":
+			out.ChangePercent = float64(in.Float64())
+		case "open":
+			out.Open = float64(in.Float64())
+		case "high":
+			out.High = float64(in.Float64())
+		case "low":
+			out.Low = float64(in.Float64())
+		case "close":
+			out.Close = float64(in.Float64())
+		case "previous_close":
+			out.PreviousClose = float64(in.Float64())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"i\":"
-		out.RawString(prefix)
-		if in.Indicators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v48, v49 := range in.Indicators {
-				if v48 > 0 {
-					out.RawByte(',')
-				}
-				out.Int32(int32(v49))
-			}
-			out.RawByte(']')
-		}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient48(out *jwriter.Writer, in IndexSession) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"p\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.BidPrice))
+		const prefix string = ",\"change\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Change))
 	}
 	{
-		const prefix string = ",\"x\":"
+		const prefix string = ",\"change_percent\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.BidExchange))
+		out.Float64(float64(in.ChangePercent))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"open\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.BidSize))
+		out.Float64(float64(in.Open))
 	}
 	{
-		const prefix string = ",\"P\":"
+		const prefix string = ",\"high\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.AskPrice))
+		out.Float64(float64(in.High))
 	}
 	{
-		const prefix string = ",\"X\":"
+		const prefix string = ",\"low\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskExchange))
+		out.Float64(float64(in.Low))
 	}
 	{
-		const prefix string = ",\"S\":"
+		const prefix string = ",\"close\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskSize))
+		out.Float64(float64(in.Close))
 	}
 	{
-		const prefix string = ",\"z\":"
+		const prefix string = ",\"previous_close\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.ListedEx))
+		out.Float64(float64(in.PreviousClose))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Quote) MarshalJSON() ([]byte, error) {
+func (v IndexSession) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Quote) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient20(w, v)
+func (v IndexSession) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Quote) UnmarshalJSON(data []byte) error {
+func (v *IndexSession) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Quote) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient20(l, v)
+func (v *IndexSession) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient48(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(in *jlexer.Lexer, out *PolygonClientMsg) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient49(in *jlexer.Lexer, out *IndexBarsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2934,10 +5923,10 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "action":
-			out.Action = string(in.String())
-		case "params":
-			out.Params = string(in.String())
+		case "queryCount":
+			out.QueryCount = int32(in.Int32())
+		case "results":
+			(out.Results).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2948,47 +5937,113 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(out *jwriter.Writer, in PolygonClientMsg) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient49(out *jwriter.Writer, in IndexBarsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"action\":"
+		const prefix string = ",\"queryCount\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Action))
+		out.Int32(int32(in.QueryCount))
 	}
 	{
-		const prefix string = ",\"params\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix)
-		out.String(string(in.Params))
+		(in.Results).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PolygonClientMsg) MarshalJSON() ([]byte, error) {
+func (v IndexBarsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PolygonClientMsg) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient21(w, v)
+func (v IndexBarsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PolygonClientMsg) UnmarshalJSON(data []byte) error {
+func (v *IndexBarsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PolygonClientMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient21(l, v)
+func (v *IndexBarsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient49(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient50(in *jlexer.Lexer, out *IndexBars) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(IndexBars, 0, 1)
+			} else {
+				*out = IndexBars{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v78 IndexBar
+			(v78).UnmarshalEasyJSON(in)
+			*out = append(*out, v78)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient50(out *jwriter.Writer, in IndexBars) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v79, v80 := range in {
+			if v79 > 0 {
+				out.RawByte(',')
+			}
+			(v80).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IndexBars) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IndexBars) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IndexBars) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IndexBars) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient50(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(in *jlexer.Lexer, out *PolygonAuthMsg) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient51(in *jlexer.Lexer, out *IndexBar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3007,12 +6062,18 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "ev":
-			out.Event = string(in.String())
-		case "status":
-			out.Status = string(in.String())
-		case "message":
-			out.Message = string(in.String())
+		case "T":
+			out.Ticker = string(in.String())
+		case "t":
+			out.Time = int64(in.Int64())
+		case "o":
+			out.Open = float64(in.Float64())
+		case "c":
+			out.Close = float64(in.Float64())
+		case "h":
+			out.High = float64(in.Float64())
+		case "l":
+			out.Low = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3023,52 +6084,67 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(out *jwriter.Writer, in PolygonAuthMsg) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient51(out *jwriter.Writer, in IndexBar) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ev\":"
+		const prefix string = ",\"T\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Event))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"t\":"
 		out.RawString(prefix)
-		out.String(string(in.Status))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"o\":"
 		out.RawString(prefix)
-		out.String(string(in.Message))
+		out.Float64(float64(in.Open))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Close))
+	}
+	{
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.High))
+	}
+	{
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Low))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PolygonAuthMsg) MarshalJSON() ([]byte, error) {
+func (v IndexBar) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PolygonAuthMsg) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient22(w, v)
+func (v IndexBar) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PolygonAuthMsg) UnmarshalJSON(data []byte) error {
+func (v *IndexBar) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PolygonAuthMsg) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient22(l, v)
+func (v *IndexBar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient51(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(in *jlexer.Lexer, out *NewsOptions) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient52(in *jlexer.Lexer, out *ForexTicksOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3087,10 +6163,10 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "PerPage":
-			out.PerPage = int32(in.Int32())
-		case "Page":
-			out.Page = int32(in.Int32())
+		case "Offset":
+			out.Offset = int64(in.Int64())
+		case "Limit":
+			out.Limit = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3101,47 +6177,47 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(out *jwriter.Writer, in NewsOptions) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient52(out *jwriter.Writer, in ForexTicksOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"PerPage\":"
+		const prefix string = ",\"Offset\":"
 		out.RawString(prefix[1:])
-		out.Int32(int32(in.PerPage))
+		out.Int64(int64(in.Offset))
 	}
 	{
-		const prefix string = ",\"Page\":"
+		const prefix string = ",\"Limit\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Page))
+		out.Int64(int64(in.Limit))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NewsOptions) MarshalJSON() ([]byte, error) {
+func (v ForexTicksOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NewsOptions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient23(w, v)
+func (v ForexTicksOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NewsOptions) UnmarshalJSON(data []byte) error {
+func (v *ForexTicksOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NewsOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient23(l, v)
+func (v *ForexTicksOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient52(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(in *jlexer.Lexer, out *MarketStatus) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient53(in *jlexer.Lexer, out *ForexTick) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3160,42 +6236,14 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "market":
-			out.Market = string(in.String())
-		case "serverTime":
-			out.ServerTime = string(in.String())
-		case "exchanges":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.Exchanges = make(map[string]string)
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v50 string
-					v50 = string(in.String())
-					(out.Exchanges)[key] = v50
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "currencies":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.Currencies = make(map[string]string)
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v51 string
-					v51 = string(in.String())
-					(out.Currencies)[key] = v51
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
+		case "a":
+			out.Ask = float64(in.Float64())
+		case "b":
+			out.Bid = float64(in.Float64())
+		case "x":
+			out.Exchange = int32(in.Int32())
+		case "t":
+			out.Time = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3206,89 +6254,57 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(out *jwriter.Writer, in MarketStatus) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient53(out *jwriter.Writer, in ForexTick) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"market\":"
+		const prefix string = ",\"a\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Market))
+		out.Float64(float64(in.Ask))
 	}
 	{
-		const prefix string = ",\"serverTime\":"
+		const prefix string = ",\"b\":"
 		out.RawString(prefix)
-		out.String(string(in.ServerTime))
+		out.Float64(float64(in.Bid))
 	}
 	{
-		const prefix string = ",\"exchanges\":"
+		const prefix string = ",\"x\":"
 		out.RawString(prefix)
-		if in.Exchanges == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v52First := true
-			for v52Name, v52Value := range in.Exchanges {
-				if v52First {
-					v52First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v52Name))
-				out.RawByte(':')
-				out.String(string(v52Value))
-			}
-			out.RawByte('}')
-		}
+		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"currencies\":"
+		const prefix string = ",\"t\":"
 		out.RawString(prefix)
-		if in.Currencies == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v53First := true
-			for v53Name, v53Value := range in.Currencies {
-				if v53First {
-					v53First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v53Name))
-				out.RawByte(':')
-				out.String(string(v53Value))
-			}
-			out.RawByte('}')
-		}
+		out.Int64(int64(in.Time))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarketStatus) MarshalJSON() ([]byte, error) {
+func (v ForexTick) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarketStatus) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient24(w, v)
+func (v ForexTick) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarketStatus) UnmarshalJSON(data []byte) error {
+func (v *ForexTick) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarketStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient24(l, v)
+func (v *ForexTick) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient53(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(in *jlexer.Lexer, out *MarketHoliday) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient54(in *jlexer.Lexer, out *ForexSnapshotsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3307,18 +6323,29 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "exchange":
-			out.Exchange = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "status":
-			out.Status = string(in.String())
-		case "date":
-			out.Date = string(in.String())
-		case "open":
-			out.Open = string(in.String())
-		case "close":
-			out.Close = string(in.String())
+		case "tickers":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(ForexSnapshots, 0, 0)
+					} else {
+						out.Results = ForexSnapshots{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 ForexSnapshot
+					(v81).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3329,67 +6356,53 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(out *jwriter.Writer, in MarketHoliday) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient54(out *jwriter.Writer, in ForexSnapshotsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"exchange\":"
+		const prefix string = ",\"tickers\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Exchange))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
-	}
-	{
-		const prefix string = ",\"date\":"
-		out.RawString(prefix)
-		out.String(string(in.Date))
-	}
-	{
-		const prefix string = ",\"open\":"
-		out.RawString(prefix)
-		out.String(string(in.Open))
-	}
-	{
-		const prefix string = ",\"close\":"
-		out.RawString(prefix)
-		out.String(string(in.Close))
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v82, v83 := range in.Results {
+				if v82 > 0 {
+					out.RawByte(',')
+				}
+				(v83).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarketHoliday) MarshalJSON() ([]byte, error) {
+func (v ForexSnapshotsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarketHoliday) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient25(w, v)
+func (v ForexSnapshotsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarketHoliday) UnmarshalJSON(data []byte) error {
+func (v *ForexSnapshotsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarketHoliday) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient25(l, v)
+func (v *ForexSnapshotsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient54(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(in *jlexer.Lexer, out *MarketDescription) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient55(in *jlexer.Lexer, out *ForexSnapshot) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3408,10 +6421,22 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "market":
-			out.Name = Market(in.String())
-		case "desc":
-			out.Description = string(in.String())
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "todaysChange":
+			out.TodayChange = float32(in.Float32())
+		case "todaysChangePerc":
+			out.TodayChangePct = float32(in.Float32())
+		case "day":
+			(out.Day).UnmarshalEasyJSON(in)
+		case "prevDay":
+			(out.PrevDay).UnmarshalEasyJSON(in)
+		case "lastQuote":
+			(out.LastQuote).UnmarshalEasyJSON(in)
+		case "min":
+			(out.Min).UnmarshalEasyJSON(in)
+		case "updated":
+			out.Updated = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3422,47 +6447,77 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(out *jwriter.Writer, in MarketDescription) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient55(out *jwriter.Writer, in ForexSnapshot) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"market\":"
+		const prefix string = ",\"ticker\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"desc\":"
+		const prefix string = ",\"todaysChange\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Float32(float32(in.TodayChange))
+	}
+	{
+		const prefix string = ",\"todaysChangePerc\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.TodayChangePct))
+	}
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		(in.Day).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"prevDay\":"
+		out.RawString(prefix)
+		(in.PrevDay).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"lastQuote\":"
+		out.RawString(prefix)
+		(in.LastQuote).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		(in.Min).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Updated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarketDescription) MarshalJSON() ([]byte, error) {
+func (v ForexSnapshot) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarketDescription) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient26(w, v)
+func (v ForexSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarketDescription) UnmarshalJSON(data []byte) error {
+func (v *ForexSnapshot) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarketDescription) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient26(l, v)
+func (v *ForexSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient55(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(in *jlexer.Lexer, out *LocaleName) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient56(in *jlexer.Lexer, out *ForexQuote) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3481,10 +6536,14 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "locale":
-			out.Locale = Locale(in.String())
-		case "name":
-			out.Name = string(in.String())
+		case "ask":
+			out.Ask = float64(in.Float64())
+		case "bid":
+			out.Bid = float64(in.Float64())
+		case "exchange":
+			out.Exchange = int32(in.Int32())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3495,47 +6554,57 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(out *jwriter.Writer, in LocaleName) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient56(out *jwriter.Writer, in ForexQuote) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"locale\":"
+		const prefix string = ",\"ask\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Locale))
+		out.Float64(float64(in.Ask))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"bid\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Float64(float64(in.Bid))
+	}
+	{
+		const prefix string = ",\"exchange\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Exchange))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LocaleName) MarshalJSON() ([]byte, error) {
+func (v ForexQuote) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LocaleName) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient27(w, v)
+func (v ForexQuote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LocaleName) UnmarshalJSON(data []byte) error {
+func (v *ForexQuote) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LocaleName) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient27(l, v)
+func (v *ForexQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient56(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(in *jlexer.Lexer, out *LastTrade) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient57(in *jlexer.Lexer, out *ForexHistoricTicksResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3554,16 +6623,33 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "cond1":
-			out.Condition1 = int32(in.Int32())
-		case "exchange":
-			out.Exchange = int32(in.Int32())
-		case "float64":
-			out.Price = float64(in.Float64())
-		case "size":
-			out.Size = int32(in.Int32())
-		case "timestamp":
-			out.Timestamp = int64(in.Int64())
+		case "day":
+			out.Day = string(in.String())
+		case "pair":
+			out.Pair = string(in.String())
+		case "ticks":
+			if in.IsNull() {
+				in.Skip()
+				out.Ticks = nil
+			} else {
+				in.Delim('[')
+				if out.Ticks == nil {
+					if !in.IsDelim(']') {
+						out.Ticks = make(ForexTicks, 0, 2)
+					} else {
+						out.Ticks = ForexTicks{}
+					}
+				} else {
+					out.Ticks = (out.Ticks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 ForexTick
+					(v84).UnmarshalEasyJSON(in)
+					out.Ticks = append(out.Ticks, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3574,62 +6660,63 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(out *jwriter.Writer, in LastTrade) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient57(out *jwriter.Writer, in ForexHistoricTicksResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cond1\":"
+		const prefix string = ",\"day\":"
 		out.RawString(prefix[1:])
-		out.Int32(int32(in.Condition1))
-	}
-	{
-		const prefix string = ",\"exchange\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.Exchange))
-	}
-	{
-		const prefix string = ",\"float64\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		out.String(string(in.Day))
 	}
 	{
-		const prefix string = ",\"size\":"
+		const prefix string = ",\"pair\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Size))
+		out.String(string(in.Pair))
 	}
 	{
-		const prefix string = ",\"timestamp\":"
+		const prefix string = ",\"ticks\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		if in.Ticks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.Ticks {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				(v86).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LastTrade) MarshalJSON() ([]byte, error) {
+func (v ForexHistoricTicksResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LastTrade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient28(w, v)
+func (v ForexHistoricTicksResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LastTrade) UnmarshalJSON(data []byte) error {
+func (v *ForexHistoricTicksResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LastTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient28(l, v)
+func (v *ForexHistoricTicksResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient57(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(in *jlexer.Lexer, out *LastQuote) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient58(in *jlexer.Lexer, out *ForexConversion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3643,25 +6730,21 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(in *jlexer.Lexer, out *
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "bidprice":
-			out.BidPrice = float64(in.Float64())
-		case "bidexchange":
-			out.BidExchange = int32(in.Int32())
-		case "bidsize":
-			out.BidSize = int32(in.Int32())
-		case "askprice":
-			out.AskPrice = float64(in.Float64())
-		case "askexchange":
-			out.AskExchange = int32(in.Int32())
-		case "asksize":
-			out.AskSize = int32(in.Int32())
-		case "timestamp":
-			out.Timestamp = int64(in.Int64())
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			out.From = string(in.String())
+		case "to":
+			out.To = string(in.String())
+		case "initialAmount":
+			out.InitialAmount = float64(in.Float64())
+		case "converted":
+			out.Converted = float64(in.Float64())
+		case "last":
+			(out.Last).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -3672,72 +6755,62 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(out *jwriter.Writer, in LastQuote) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient58(out *jwriter.Writer, in ForexConversion) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"bidprice\":"
+		const prefix string = ",\"from\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.BidPrice))
-	}
-	{
-		const prefix string = ",\"bidexchange\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.BidExchange))
-	}
-	{
-		const prefix string = ",\"bidsize\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.BidSize))
+		out.String(string(in.From))
 	}
 	{
-		const prefix string = ",\"askprice\":"
+		const prefix string = ",\"to\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.AskPrice))
+		out.String(string(in.To))
 	}
 	{
-		const prefix string = ",\"askexchange\":"
+		const prefix string = ",\"initialAmount\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskExchange))
+		out.Float64(float64(in.InitialAmount))
 	}
 	{
-		const prefix string = ",\"asksize\":"
+		const prefix string = ",\"converted\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.AskSize))
+		out.Float64(float64(in.Converted))
 	}
 	{
-		const prefix string = ",\"timestamp\":"
+		const prefix string = ",\"last\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		(in.Last).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LastQuote) MarshalJSON() ([]byte, error) {
+func (v ForexConversion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LastQuote) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient29(w, v)
+func (v ForexConversion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LastQuote) UnmarshalJSON(data []byte) error {
+func (v *ForexConversion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LastQuote) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient29(l, v)
+func (v *ForexConversion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient58(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(in *jlexer.Lexer, out *FinancialOptions) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient59(in *jlexer.Lexer, out *FinancialOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3772,7 +6845,7 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(out *jwriter.Writer, in FinancialOptions) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient59(out *jwriter.Writer, in FinancialOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3797,27 +6870,27 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v FinancialOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FinancialOptions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient30(w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FinancialOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FinancialOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient30(l, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient59(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(in *jlexer.Lexer, out *Financial) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient60(in *jlexer.Lexer, out *Financial) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4066,7 +7139,7 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(out *jwriter.Writer, in Financial) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient60(out *jwriter.Writer, in Financial) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4293,360 +7366,1009 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(out *jwriter.Writer, in
 	{
 		const prefix string = ",\"freeCashFlowPerShare\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.FreeCashFlowPerShare))
+		out.Float32(float32(in.FreeCashFlowPerShare))
+	}
+	{
+		const prefix string = ",\"foreignCurrencyUSDExchangeRate\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.ForeignCurrencyUSDExchangeRate))
+	}
+	{
+		const prefix string = ",\"grossProfit\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.GrossProfit))
+	}
+	{
+		const prefix string = ",\"grossMargin\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.GrossMargin))
+	}
+	{
+		const prefix string = ",\"goodwillAndIntangibleAssets\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.GoodwillAndIntangibleAssets))
+	}
+	{
+		const prefix string = ",\"interestExpense\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.InterestExpense))
+	}
+	{
+		const prefix string = ",\"investedCapital\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.InvestedCapital))
+	}
+	{
+		const prefix string = ",\"investedCapitalAverage\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.InvestedCapitalAverage))
+	}
+	{
+		const prefix string = ",\"inventory\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Inventory))
+	}
+	{
+		const prefix string = ",\"investments\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Investments))
+	}
+	{
+		const prefix string = ",\"investmentsCurrent\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.InvestmentsCurrent))
+	}
+	{
+		const prefix string = ",\"investmentsNonCurrent\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.InvestmentsNonCurrent))
+	}
+	{
+		const prefix string = ",\"totalLiabilities\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.TotalLiabilities))
+	}
+	{
+		const prefix string = ",\"currentLiabilities\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CurrentLiabilities))
+	}
+	{
+		const prefix string = ",\"liabilitiesNonCurrent\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.LiabilitiesNonCurrent))
+	}
+	{
+		const prefix string = ",\"marketCapitalization\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.MarketCapitalization))
+	}
+	{
+		const prefix string = ",\"netCashFlow\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlow))
+	}
+	{
+		const prefix string = ",\"netCashFlowBusinessAcquisitionsDisposals\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlowBusinessAcquisitionsDisposals))
+	}
+	{
+		const prefix string = ",\"issuanceEquityShares\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.IssuanceEquityShares))
+	}
+	{
+		const prefix string = ",\"issuanceDebtSecurities\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.IssuanceDebtSecurities))
+	}
+	{
+		const prefix string = ",\"paymentDividendsOtherCashDistributions\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PaymentDividendsOtherCashDistributions))
+	}
+	{
+		const prefix string = ",\"netCashFlowFromFinancing\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlowFromFinancing))
+	}
+	{
+		const prefix string = ",\"netCashFlowFromInvesting\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlowFromInvesting))
+	}
+	{
+		const prefix string = ",\"netCashFlowInvestmentAcquisitionsDisposals\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlowInvestmentAcquisitionsDisposals))
+	}
+	{
+		const prefix string = ",\"netCashFlowFromOperations\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetCashFlowFromOperations))
+	}
+	{
+		const prefix string = ",\"effectOfExchangeRateChangesOnCash\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.EffectOfExchangeRateChangesOnCash))
+	}
+	{
+		const prefix string = ",\"netIncome\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetIncome))
+	}
+	{
+		const prefix string = ",\"netIncomeCommonStock\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetIncomeCommonStock))
+	}
+	{
+		const prefix string = ",\"netIncomeCommonStockUSD\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetIncomeCommonStockUSD))
+	}
+	{
+		const prefix string = ",\"netLossIncomeFromDiscontinuedOperations\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetLossIncomeFromDiscontinuedOperations))
+	}
+	{
+		const prefix string = ",\"netIncomeToNonControllingInterests\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.NetIncomeToNonControllingInterests))
+	}
+	{
+		const prefix string = ",\"profitMargin\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.ProfitMargin))
+	}
+	{
+		const prefix string = ",\"operatingExpenses\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OperatingExpenses))
+	}
+	{
+		const prefix string = ",\"operatingIncome\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.OperatingIncome))
+	}
+	{
+		const prefix string = ",\"tradeAndNonTradePayables\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.TradeAndNonTradePayables))
+	}
+	{
+		const prefix string = ",\"payoutRatio\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PayoutRatio))
+	}
+	{
+		const prefix string = ",\"priceToBookValue\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PriceToBookValue))
+	}
+	{
+		const prefix string = ",\"priceEarnings\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PriceEarnings))
+	}
+	{
+		const prefix string = ",\"priceToEarningsRatio\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PriceToEarningsRatio))
+	}
+	{
+		const prefix string = ",\"propertyPlantEquipmentNet\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PropertyPlantEquipmentNet))
+	}
+	{
+		const prefix string = ",\"preferredDividendsIncomeStatementImpact\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PreferredDividendsIncomeStatementImpact))
+	}
+	{
+		const prefix string = ",\"sharePriceAdjustedClose\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.SharePriceAdjustedClose))
+	}
+	{
+		const prefix string = ",\"priceSales\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PriceSales))
+	}
+	{
+		const prefix string = ",\"priceToSalesRatio\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.PriceToSalesRatio))
 	}
 	{
-		const prefix string = ",\"foreignCurrencyUSDExchangeRate\":"
+		const prefix string = ",\"tradeAndNonTradeReceivables\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ForeignCurrencyUSDExchangeRate))
+		out.Float32(float32(in.TradeAndNonTradeReceivables))
 	}
 	{
-		const prefix string = ",\"grossProfit\":"
+		const prefix string = ",\"accumulatedRetainedEarningsDeficit\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.GrossProfit))
+		out.Float32(float32(in.AccumulatedRetainedEarningsDeficit))
 	}
 	{
-		const prefix string = ",\"grossMargin\":"
+		const prefix string = ",\"revenues\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.GrossMargin))
+		out.Float32(float32(in.Revenues))
 	}
 	{
-		const prefix string = ",\"goodwillAndIntangibleAssets\":"
+		const prefix string = ",\"revenuesUSD\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.GoodwillAndIntangibleAssets))
+		out.Float32(float32(in.RevenuesUSD))
 	}
 	{
-		const prefix string = ",\"interestExpense\":"
+		const prefix string = ",\"researchAndDevelopmentExpense\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.InterestExpense))
+		out.Float32(float32(in.ResearchAndDevelopmentExpense))
 	}
 	{
-		const prefix string = ",\"investedCapital\":"
+		const prefix string = ",\"returnOnAverageAssets\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.InvestedCapital))
+		out.Float32(float32(in.ReturnOnAverageAssets))
 	}
 	{
-		const prefix string = ",\"investedCapitalAverage\":"
+		const prefix string = ",\"returnOnAverageEquity\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.InvestedCapitalAverage))
+		out.Float32(float32(in.ReturnOnAverageEquity))
 	}
 	{
-		const prefix string = ",\"inventory\":"
+		const prefix string = ",\"returnOnInvestedCapital\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Inventory))
+		out.Float32(float32(in.ReturnOnInvestedCapital))
 	}
 	{
-		const prefix string = ",\"investments\":"
+		const prefix string = ",\"returnOnSales\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Investments))
+		out.Float32(float32(in.ReturnOnSales))
 	}
 	{
-		const prefix string = ",\"investmentsCurrent\":"
+		const prefix string = ",\"shareBasedCompensation\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.InvestmentsCurrent))
+		out.Float32(float32(in.ShareBasedCompensation))
 	}
 	{
-		const prefix string = ",\"investmentsNonCurrent\":"
+		const prefix string = ",\"sellingGeneralAndAdministrativeExpense\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.InvestmentsNonCurrent))
+		out.Float32(float32(in.SellingGeneralAndAdministrativeExpense))
 	}
 	{
-		const prefix string = ",\"totalLiabilities\":"
+		const prefix string = ",\"shareFactor\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.TotalLiabilities))
+		out.Float32(float32(in.ShareFactor))
 	}
 	{
-		const prefix string = ",\"currentLiabilities\":"
+		const prefix string = ",\"shares\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CurrentLiabilities))
+		out.Float32(float32(in.Shares))
 	}
 	{
-		const prefix string = ",\"liabilitiesNonCurrent\":"
+		const prefix string = ",\"weightedAverageShares\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.LiabilitiesNonCurrent))
+		out.Float32(float32(in.WeightedAverageShares))
 	}
 	{
-		const prefix string = ",\"marketCapitalization\":"
+		const prefix string = ",\"weightedAverageSharesDiluted\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.MarketCapitalization))
+		out.Float32(float32(in.WeightedAverageSharesDiluted))
 	}
 	{
-		const prefix string = ",\"netCashFlow\":"
+		const prefix string = ",\"salesPerShare\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlow))
+		out.Float32(float32(in.SalesPerShare))
 	}
 	{
-		const prefix string = ",\"netCashFlowBusinessAcquisitionsDisposals\":"
+		const prefix string = ",\"tangibleAssetValue\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlowBusinessAcquisitionsDisposals))
+		out.Float32(float32(in.TangibleAssetValue))
 	}
 	{
-		const prefix string = ",\"issuanceEquityShares\":"
+		const prefix string = ",\"taxAssets\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.IssuanceEquityShares))
+		out.Float32(float32(in.TaxAssets))
 	}
 	{
-		const prefix string = ",\"issuanceDebtSecurities\":"
+		const prefix string = ",\"incomeTaxExpense\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.IssuanceDebtSecurities))
+		out.Float32(float32(in.IncomeTaxExpense))
 	}
 	{
-		const prefix string = ",\"paymentDividendsOtherCashDistributions\":"
+		const prefix string = ",\"taxLiabilities\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PaymentDividendsOtherCashDistributions))
+		out.Float32(float32(in.TaxLiabilities))
 	}
 	{
-		const prefix string = ",\"netCashFlowFromFinancing\":"
+		const prefix string = ",\"tangibleAssetsBookValuePerShare\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlowFromFinancing))
+		out.Float32(float32(in.TangibleAssetsBookValuePerShare))
 	}
 	{
-		const prefix string = ",\"netCashFlowFromInvesting\":"
+		const prefix string = ",\"workingCapital\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlowFromInvesting))
+		out.Float32(float32(in.WorkingCapital))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Financial) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Financial) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Financial) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Financial) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient60(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient61(in *jlexer.Lexer, out *Exchange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int32(in.Int32())
+		case "type":
+			out.Type = string(in.String())
+		case "market":
+			out.Market = string(in.String())
+		case "mic":
+			out.Mic = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "tape":
+			out.Tape = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient61(out *jwriter.Writer, in Exchange) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"netCashFlowInvestmentAcquisitionsDisposals\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlowInvestmentAcquisitionsDisposals))
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.ID))
 	}
 	{
-		const prefix string = ",\"netCashFlowFromOperations\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetCashFlowFromOperations))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"effectOfExchangeRateChangesOnCash\":"
+		const prefix string = ",\"market\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.EffectOfExchangeRateChangesOnCash))
+		out.String(string(in.Market))
 	}
 	{
-		const prefix string = ",\"netIncome\":"
+		const prefix string = ",\"mic\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetIncome))
+		out.String(string(in.Mic))
 	}
 	{
-		const prefix string = ",\"netIncomeCommonStock\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetIncomeCommonStock))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"netIncomeCommonStockUSD\":"
+		const prefix string = ",\"tape\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetIncomeCommonStockUSD))
+		out.String(string(in.Tape))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Exchange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Exchange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Exchange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Exchange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient61(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient62(in *jlexer.Lexer, out *Dividend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "exDate":
+			out.ExDate = string(in.String())
+		case "paymentDate":
+			out.PaymentDate = string(in.String())
+		case "recordDate":
+			out.RecorDate = string(in.String())
+		case "declaredDate":
+			out.DeclearedDate = string(in.String())
+		case "amount":
+			out.Amount = float32(in.Float32())
+		case "qualified":
+			out.Qualified = string(in.String())
+		case "flag":
+			out.Flag = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient62(out *jwriter.Writer, in Dividend) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"netLossIncomeFromDiscontinuedOperations\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.NetLossIncomeFromDiscontinuedOperations))
+		const prefix string = ",\"ticker\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"netIncomeToNonControllingInterests\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.NetIncomeToNonControllingInterests))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"profitMargin\":"
+		const prefix string = ",\"exDate\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ProfitMargin))
+		out.String(string(in.ExDate))
 	}
 	{
-		const prefix string = ",\"operatingExpenses\":"
+		const prefix string = ",\"paymentDate\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.OperatingExpenses))
+		out.String(string(in.PaymentDate))
 	}
 	{
-		const prefix string = ",\"operatingIncome\":"
+		const prefix string = ",\"recordDate\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.OperatingIncome))
+		out.String(string(in.RecorDate))
 	}
 	{
-		const prefix string = ",\"tradeAndNonTradePayables\":"
+		const prefix string = ",\"declaredDate\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.TradeAndNonTradePayables))
+		out.String(string(in.DeclearedDate))
 	}
 	{
-		const prefix string = ",\"payoutRatio\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PayoutRatio))
+		out.Float32(float32(in.Amount))
 	}
 	{
-		const prefix string = ",\"priceToBookValue\":"
+		const prefix string = ",\"qualified\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PriceToBookValue))
+		out.String(string(in.Qualified))
 	}
 	{
-		const prefix string = ",\"priceEarnings\":"
+		const prefix string = ",\"flag\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PriceEarnings))
+		out.String(string(in.Flag))
 	}
-	{
-		const prefix string = ",\"priceToEarningsRatio\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.PriceToEarningsRatio))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Dividend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Dividend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Dividend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Dividend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient62(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient63(in *jlexer.Lexer, out *Daily) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"propertyPlantEquipmentNet\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.PropertyPlantEquipmentNet))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "from":
+			out.From = string(in.String())
+		case "symbol":
+			out.Ticker = string(in.String())
+		case "volume":
+			out.Volume = float32(in.Float32())
+		case "open":
+			out.Open = float32(in.Float32())
+		case "close":
+			out.Close = float32(in.Float32())
+		case "high":
+			out.High = float32(in.Float32())
+		case "low":
+			out.Low = float32(in.Float32())
+		case "preMarket":
+			out.PreMarket = float32(in.Float32())
+		case "afterHours":
+			out.AfterHours = float32(in.Float32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"preferredDividendsIncomeStatementImpact\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.PreferredDividendsIncomeStatementImpact))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient63(out *jwriter.Writer, in Daily) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"sharePriceAdjustedClose\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.SharePriceAdjustedClose))
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"priceSales\":"
+		const prefix string = ",\"from\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PriceSales))
+		out.String(string(in.From))
 	}
 	{
-		const prefix string = ",\"priceToSalesRatio\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.PriceToSalesRatio))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"tradeAndNonTradeReceivables\":"
+		const prefix string = ",\"volume\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.TradeAndNonTradeReceivables))
+		out.Float32(float32(in.Volume))
 	}
 	{
-		const prefix string = ",\"accumulatedRetainedEarningsDeficit\":"
+		const prefix string = ",\"open\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.AccumulatedRetainedEarningsDeficit))
+		out.Float32(float32(in.Open))
 	}
 	{
-		const prefix string = ",\"revenues\":"
+		const prefix string = ",\"close\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Revenues))
+		out.Float32(float32(in.Close))
 	}
 	{
-		const prefix string = ",\"revenuesUSD\":"
+		const prefix string = ",\"high\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.RevenuesUSD))
+		out.Float32(float32(in.High))
 	}
 	{
-		const prefix string = ",\"researchAndDevelopmentExpense\":"
+		const prefix string = ",\"low\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ResearchAndDevelopmentExpense))
+		out.Float32(float32(in.Low))
 	}
 	{
-		const prefix string = ",\"returnOnAverageAssets\":"
+		const prefix string = ",\"preMarket\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ReturnOnAverageAssets))
+		out.Float32(float32(in.PreMarket))
 	}
 	{
-		const prefix string = ",\"returnOnAverageEquity\":"
+		const prefix string = ",\"afterHours\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ReturnOnAverageEquity))
+		out.Float32(float32(in.AfterHours))
 	}
-	{
-		const prefix string = ",\"returnOnInvestedCapital\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.ReturnOnInvestedCapital))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Daily) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Daily) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Daily) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Daily) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient63(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient64(in *jlexer.Lexer, out *CryptoTradesOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"returnOnSales\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.ReturnOnSales))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Offset":
+			out.Offset = int64(in.Int64())
+		case "Limit":
+			out.Limit = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"shareBasedCompensation\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.ShareBasedCompensation))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient64(out *jwriter.Writer, in CryptoTradesOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"sellingGeneralAndAdministrativeExpense\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.SellingGeneralAndAdministrativeExpense))
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Offset))
 	}
 	{
-		const prefix string = ",\"shareFactor\":"
+		const prefix string = ",\"Limit\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ShareFactor))
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CryptoTradesOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CryptoTradesOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CryptoTradesOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CryptoTradesOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient64(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient65(in *jlexer.Lexer, out *CryptoTrade) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"shares\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Shares))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "p":
+			out.Price = float32(in.Float32())
+		case "s":
+			out.Size = float32(in.Float32())
+		case "x":
+			out.Exchange = int32(in.Int32())
+		case "t":
+			out.Time = int64(in.Int64())
+		case "c":
+			if in.IsNull() {
+				in.Skip()
+				out.Conditions = nil
+			} else {
+				in.Delim('[')
+				if out.Conditions == nil {
+					if !in.IsDelim(']') {
+						out.Conditions = make([]int32, 0, 16)
+					} else {
+						out.Conditions = []int32{}
+					}
+				} else {
+					out.Conditions = (out.Conditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 int32
+					v87 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"weightedAverageShares\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.WeightedAverageShares))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient65(out *jwriter.Writer, in CryptoTrade) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"weightedAverageSharesDiluted\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.WeightedAverageSharesDiluted))
+		const prefix string = ",\"p\":"
+		out.RawString(prefix[1:])
+		out.Float32(float32(in.Price))
 	}
 	{
-		const prefix string = ",\"salesPerShare\":"
+		const prefix string = ",\"s\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.SalesPerShare))
+		out.Float32(float32(in.Size))
 	}
 	{
-		const prefix string = ",\"tangibleAssetValue\":"
+		const prefix string = ",\"x\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.TangibleAssetValue))
+		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"taxAssets\":"
+		const prefix string = ",\"t\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.TaxAssets))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"incomeTaxExpense\":"
+		const prefix string = ",\"c\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.IncomeTaxExpense))
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v88, v89 := range in.Conditions {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v89))
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"taxLiabilities\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.TaxLiabilities))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CryptoTrade) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CryptoTrade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CryptoTrade) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CryptoTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient65(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient66(in *jlexer.Lexer, out *CryptoSnapshotsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"tangibleAssetsBookValuePerShare\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.TangibleAssetsBookValuePerShare))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tickers":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make(CryptoSnapshots, 0, 0)
+					} else {
+						out.Results = CryptoSnapshots{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v90 CryptoSnapshot
+					(v90).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v90)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient66(out *jwriter.Writer, in CryptoSnapshotsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"workingCapital\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.WorkingCapital))
+		const prefix string = ",\"tickers\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.Results {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				(v92).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Financial) MarshalJSON() ([]byte, error) {
+func (v CryptoSnapshotsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Financial) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient31(w, v)
+func (v CryptoSnapshotsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Financial) UnmarshalJSON(data []byte) error {
+func (v *CryptoSnapshotsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Financial) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient31(l, v)
+func (v *CryptoSnapshotsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient66(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(in *jlexer.Lexer, out *Exchange) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient67(in *jlexer.Lexer, out *CryptoSnapshot) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4665,18 +8387,22 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int32(in.Int32())
-		case "type":
-			out.Type = string(in.String())
-		case "market":
-			out.Market = string(in.String())
-		case "mic":
-			out.Mic = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "tape":
-			out.Tape = string(in.String())
+		case "ticker":
+			out.Ticker = string(in.String())
+		case "todaysChange":
+			out.TodayChange = float32(in.Float32())
+		case "todaysChangePerc":
+			out.TodayChangePct = float32(in.Float32())
+		case "day":
+			(out.Day).UnmarshalEasyJSON(in)
+		case "prevDay":
+			(out.PrevDay).UnmarshalEasyJSON(in)
+		case "lastTrade":
+			(out.LastTrade).UnmarshalEasyJSON(in)
+		case "min":
+			(out.Min).UnmarshalEasyJSON(in)
+		case "updated":
+			out.Updated = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4687,67 +8413,77 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(out *jwriter.Writer, in Exchange) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient67(out *jwriter.Writer, in CryptoSnapshot) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"ticker\":"
 		out.RawString(prefix[1:])
-		out.Int32(int32(in.ID))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"todaysChange\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Float32(float32(in.TodayChange))
 	}
 	{
-		const prefix string = ",\"market\":"
+		const prefix string = ",\"todaysChangePerc\":"
 		out.RawString(prefix)
-		out.String(string(in.Market))
+		out.Float32(float32(in.TodayChangePct))
 	}
 	{
-		const prefix string = ",\"mic\":"
+		const prefix string = ",\"day\":"
 		out.RawString(prefix)
-		out.String(string(in.Mic))
+		(in.Day).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"prevDay\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		(in.PrevDay).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"tape\":"
+		const prefix string = ",\"lastTrade\":"
 		out.RawString(prefix)
-		out.String(string(in.Tape))
+		(in.LastTrade).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		(in.Min).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Updated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Exchange) MarshalJSON() ([]byte, error) {
+func (v CryptoSnapshot) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Exchange) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient32(w, v)
+func (v CryptoSnapshot) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Exchange) UnmarshalJSON(data []byte) error {
+func (v *CryptoSnapshot) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Exchange) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient32(l, v)
+func (v *CryptoSnapshot) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient67(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(in *jlexer.Lexer, out *Dividend) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient68(in *jlexer.Lexer, out *CryptoLastTrade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4766,24 +8502,37 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "ticker":
-			out.Ticker = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "exDate":
-			out.ExDate = string(in.String())
-		case "paymentDate":
-			out.PaymentDate = string(in.String())
-		case "recordDate":
-			out.RecorDate = string(in.String())
-		case "declaredDate":
-			out.DeclearedDate = string(in.String())
-		case "amount":
-			out.Amount = float32(in.Float32())
-		case "qualified":
-			out.Qualified = string(in.String())
-		case "flag":
-			out.Flag = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "size":
+			out.Size = float64(in.Float64())
+		case "exchange":
+			out.Exchange = int32(in.Int32())
+		case "conditions":
+			if in.IsNull() {
+				in.Skip()
+				out.Conditions = nil
+			} else {
+				in.Delim('[')
+				if out.Conditions == nil {
+					if !in.IsDelim(']') {
+						out.Conditions = make([]int32, 0, 16)
+					} else {
+						out.Conditions = []int32{}
+					}
+				} else {
+					out.Conditions = (out.Conditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v93 int32
+					v93 = int32(in.Int32())
+					out.Conditions = append(out.Conditions, v93)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -4794,82 +8543,73 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(out *jwriter.Writer, in Dividend) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient68(out *jwriter.Writer, in CryptoLastTrade) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ticker\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Ticker))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"exDate\":"
-		out.RawString(prefix)
-		out.String(string(in.ExDate))
-	}
-	{
-		const prefix string = ",\"paymentDate\":"
-		out.RawString(prefix)
-		out.String(string(in.PaymentDate))
-	}
-	{
-		const prefix string = ",\"recordDate\":"
-		out.RawString(prefix)
-		out.String(string(in.RecorDate))
+		out.Float64(float64(in.Price))
 	}
 	{
-		const prefix string = ",\"declaredDate\":"
+		const prefix string = ",\"size\":"
 		out.RawString(prefix)
-		out.String(string(in.DeclearedDate))
+		out.Float64(float64(in.Size))
 	}
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"exchange\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Amount))
+		out.Int32(int32(in.Exchange))
 	}
 	{
-		const prefix string = ",\"qualified\":"
+		const prefix string = ",\"conditions\":"
 		out.RawString(prefix)
-		out.String(string(in.Qualified))
+		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.Conditions {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				out.Int32(int32(v95))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"flag\":"
+		const prefix string = ",\"timestamp\":"
 		out.RawString(prefix)
-		out.String(string(in.Flag))
+		out.Int64(int64(in.Timestamp))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Dividend) MarshalJSON() ([]byte, error) {
+func (v CryptoLastTrade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Dividend) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient33(w, v)
+func (v CryptoLastTrade) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Dividend) UnmarshalJSON(data []byte) error {
+func (v *CryptoLastTrade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Dividend) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient33(l, v)
+func (v *CryptoLastTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient68(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(in *jlexer.Lexer, out *Daily) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient69(in *jlexer.Lexer, out *CryptoHistoricTradesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4888,26 +8628,33 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
-		case "from":
-			out.From = string(in.String())
+		case "day":
+			out.Day = string(in.String())
 		case "symbol":
-			out.Ticker = string(in.String())
-		case "volume":
-			out.Volume = float32(in.Float32())
-		case "open":
-			out.Open = float32(in.Float32())
-		case "close":
-			out.Close = float32(in.Float32())
-		case "high":
-			out.High = float32(in.Float32())
-		case "low":
-			out.Low = float32(in.Float32())
-		case "preMarket":
-			out.PreMarket = float32(in.Float32())
-		case "afterHours":
-			out.AfterHours = float32(in.Float32())
+			out.Symbol = string(in.String())
+		case "ticks":
+			if in.IsNull() {
+				in.Skip()
+				out.Ticks = nil
+			} else {
+				in.Delim('[')
+				if out.Ticks == nil {
+					if !in.IsDelim(']') {
+						out.Ticks = make(CryptoTrades, 0, 1)
+					} else {
+						out.Ticks = CryptoTrades{}
+					}
+				} else {
+					out.Ticks = (out.Ticks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v96 CryptoTrade
+					(v96).UnmarshalEasyJSON(in)
+					out.Ticks = append(out.Ticks, v96)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4918,87 +8665,63 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(out *jwriter.Writer, in Daily) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient69(out *jwriter.Writer, in CryptoHistoricTradesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"day\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
-	}
-	{
-		const prefix string = ",\"from\":"
-		out.RawString(prefix)
-		out.String(string(in.From))
+		out.String(string(in.Day))
 	}
 	{
 		const prefix string = ",\"symbol\":"
 		out.RawString(prefix)
-		out.String(string(in.Ticker))
-	}
-	{
-		const prefix string = ",\"volume\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Volume))
-	}
-	{
-		const prefix string = ",\"open\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Open))
-	}
-	{
-		const prefix string = ",\"close\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Close))
-	}
-	{
-		const prefix string = ",\"high\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.High))
-	}
-	{
-		const prefix string = ",\"low\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Low))
-	}
-	{
-		const prefix string = ",\"preMarket\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.PreMarket))
+		out.String(string(in.Symbol))
 	}
 	{
-		const prefix string = ",\"afterHours\":"
+		const prefix string = ",\"ticks\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.AfterHours))
+		if in.Ticks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v97, v98 := range in.Ticks {
+				if v97 > 0 {
+					out.RawByte(',')
+				}
+				(v98).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Daily) MarshalJSON() ([]byte, error) {
+func (v CryptoHistoricTradesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Daily) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient34(w, v)
+func (v CryptoHistoricTradesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Daily) UnmarshalJSON(data []byte) error {
+func (v *CryptoHistoricTradesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Daily) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient34(l, v)
+func (v *CryptoHistoricTradesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient69(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(in *jlexer.Lexer, out *CryptoTrade) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient70(in *jlexer.Lexer, out *CryptoDaily) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5017,33 +8740,58 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "p":
-			out.Price = float32(in.Float32())
-		case "s":
-			out.Size = float32(in.Float32())
-		case "x":
-			out.Exchange = int32(in.Int32())
-		case "t":
-			out.Time = int64(in.Int64())
-		case "c":
+		case "symbol":
+			out.Ticker = string(in.String())
+		case "isUTC":
+			out.IsUTC = bool(in.Bool())
+		case "day":
+			out.Day = string(in.String())
+		case "open":
+			out.Open = float32(in.Float32())
+		case "close":
+			out.Close = float32(in.Float32())
+		case "openTrades":
 			if in.IsNull() {
 				in.Skip()
-				out.Conditions = nil
+				out.OpenTrades = nil
 			} else {
 				in.Delim('[')
-				if out.Conditions == nil {
+				if out.OpenTrades == nil {
 					if !in.IsDelim(']') {
-						out.Conditions = make([]int32, 0, 16)
+						out.OpenTrades = make(CryptoTrades, 0, 1)
 					} else {
-						out.Conditions = []int32{}
+						out.OpenTrades = CryptoTrades{}
 					}
 				} else {
-					out.Conditions = (out.Conditions)[:0]
+					out.OpenTrades = (out.OpenTrades)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v99 CryptoTrade
+					(v99).UnmarshalEasyJSON(in)
+					out.OpenTrades = append(out.OpenTrades, v99)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "closingTrades":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosingTrades = nil
+			} else {
+				in.Delim('[')
+				if out.ClosingTrades == nil {
+					if !in.IsDelim(']') {
+						out.ClosingTrades = make(CryptoTrades, 0, 1)
+					} else {
+						out.ClosingTrades = CryptoTrades{}
+					}
+				} else {
+					out.ClosingTrades = (out.ClosingTrades)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v54 int32
-					v54 = int32(in.Int32())
-					out.Conditions = append(out.Conditions, v54)
+					var v100 CryptoTrade
+					(v100).UnmarshalEasyJSON(in)
+					out.ClosingTrades = append(out.ClosingTrades, v100)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5058,42 +8806,63 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(out *jwriter.Writer, in CryptoTrade) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient70(out *jwriter.Writer, in CryptoDaily) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"p\":"
+		const prefix string = ",\"symbol\":"
 		out.RawString(prefix[1:])
-		out.Float32(float32(in.Price))
+		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"isUTC\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsUTC))
+	}
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		out.String(string(in.Day))
+	}
+	{
+		const prefix string = ",\"open\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Size))
+		out.Float32(float32(in.Open))
 	}
 	{
-		const prefix string = ",\"x\":"
+		const prefix string = ",\"close\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Exchange))
+		out.Float32(float32(in.Close))
 	}
 	{
-		const prefix string = ",\"t\":"
+		const prefix string = ",\"openTrades\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		if in.OpenTrades == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.OpenTrades {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"c\":"
+		const prefix string = ",\"closingTrades\":"
 		out.RawString(prefix)
-		if in.Conditions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.ClosingTrades == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v55, v56 := range in.Conditions {
-				if v55 > 0 {
+			for v103, v104 := range in.ClosingTrades {
+				if v103 > 0 {
 					out.RawByte(',')
 				}
-				out.Int32(int32(v56))
+				(v104).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5102,29 +8871,29 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(out *jwriter.Writer, in
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CryptoTrade) MarshalJSON() ([]byte, error) {
+func (v CryptoDaily) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CryptoTrade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient35(w, v)
+func (v CryptoDaily) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CryptoTrade) UnmarshalJSON(data []byte) error {
+func (v *CryptoDaily) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CryptoTrade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient35(l, v)
+func (v *CryptoDaily) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient70(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(in *jlexer.Lexer, out *CryptoDaily) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient71(in *jlexer.Lexer, out *CryptoBook) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5143,62 +8912,62 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "symbol":
+		case "ticker":
 			out.Ticker = string(in.String())
-		case "isUTC":
-			out.IsUTC = bool(in.Bool())
-		case "day":
-			out.Day = string(in.String())
-		case "open":
-			out.Open = float32(in.Float32())
-		case "close":
-			out.Close = float32(in.Float32())
-		case "openTrades":
+		case "bids":
 			if in.IsNull() {
 				in.Skip()
-				out.OpenTrades = nil
+				out.Bids = nil
 			} else {
 				in.Delim('[')
-				if out.OpenTrades == nil {
+				if out.Bids == nil {
 					if !in.IsDelim(']') {
-						out.OpenTrades = make(CryptoTrades, 0, 1)
+						out.Bids = make([]BookLevel, 0, 4)
 					} else {
-						out.OpenTrades = CryptoTrades{}
+						out.Bids = []BookLevel{}
 					}
 				} else {
-					out.OpenTrades = (out.OpenTrades)[:0]
+					out.Bids = (out.Bids)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v57 CryptoTrade
-					(v57).UnmarshalEasyJSON(in)
-					out.OpenTrades = append(out.OpenTrades, v57)
+					var v105 BookLevel
+					(v105).UnmarshalEasyJSON(in)
+					out.Bids = append(out.Bids, v105)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "closingTrades":
+		case "asks":
 			if in.IsNull() {
 				in.Skip()
-				out.ClosingTrades = nil
+				out.Asks = nil
 			} else {
 				in.Delim('[')
-				if out.ClosingTrades == nil {
+				if out.Asks == nil {
 					if !in.IsDelim(']') {
-						out.ClosingTrades = make(CryptoTrades, 0, 1)
+						out.Asks = make([]BookLevel, 0, 4)
 					} else {
-						out.ClosingTrades = CryptoTrades{}
+						out.Asks = []BookLevel{}
 					}
 				} else {
-					out.ClosingTrades = (out.ClosingTrades)[:0]
+					out.Asks = (out.Asks)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 CryptoTrade
-					(v58).UnmarshalEasyJSON(in)
-					out.ClosingTrades = append(out.ClosingTrades, v58)
+					var v106 BookLevel
+					(v106).UnmarshalEasyJSON(in)
+					out.Asks = append(out.Asks, v106)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "bidCount":
+			out.BidCount = float64(in.Float64())
+		case "askCount":
+			out.AskCount = float64(in.Float64())
+		case "spread":
+			out.Spread = float64(in.Float64())
+		case "updated":
+			out.Updated = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -5209,94 +8978,167 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(out *jwriter.Writer, in CryptoDaily) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient71(out *jwriter.Writer, in CryptoBook) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"symbol\":"
+		const prefix string = ",\"ticker\":"
 		out.RawString(prefix[1:])
 		out.String(string(in.Ticker))
 	}
 	{
-		const prefix string = ",\"isUTC\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsUTC))
-	}
-	{
-		const prefix string = ",\"day\":"
-		out.RawString(prefix)
-		out.String(string(in.Day))
-	}
-	{
-		const prefix string = ",\"open\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Open))
-	}
-	{
-		const prefix string = ",\"close\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Close))
-	}
-	{
-		const prefix string = ",\"openTrades\":"
+		const prefix string = ",\"bids\":"
 		out.RawString(prefix)
-		if in.OpenTrades == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Bids == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.OpenTrades {
-				if v59 > 0 {
+			for v107, v108 := range in.Bids {
+				if v107 > 0 {
 					out.RawByte(',')
 				}
-				(v60).MarshalEasyJSON(out)
+				(v108).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"closingTrades\":"
+		const prefix string = ",\"asks\":"
 		out.RawString(prefix)
-		if in.ClosingTrades == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Asks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v61, v62 := range in.ClosingTrades {
-				if v61 > 0 {
+			for v109, v110 := range in.Asks {
+				if v109 > 0 {
 					out.RawByte(',')
 				}
-				(v62).MarshalEasyJSON(out)
+				(v110).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"bidCount\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BidCount))
+	}
+	{
+		const prefix string = ",\"askCount\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AskCount))
+	}
+	{
+		const prefix string = ",\"spread\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Spread))
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Updated))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CryptoDaily) MarshalJSON() ([]byte, error) {
+func (v CryptoBook) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CryptoDaily) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient36(w, v)
+func (v CryptoBook) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CryptoDaily) UnmarshalJSON(data []byte) error {
+func (v *CryptoBook) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CryptoDaily) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient36(l, v)
+func (v *CryptoBook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient71(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient72(in *jlexer.Lexer, out *ConversionOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Amount":
+			out.Amount = float64(in.Float64())
+		case "Precision":
+			out.Precision = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient72(out *jwriter.Writer, in ConversionOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Amount\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Amount))
+	}
+	{
+		const prefix string = ",\"Precision\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Precision))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConversionOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConversionOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConversionOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConversionOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient72(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(in *jlexer.Lexer, out *CommonResponse) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient73(in *jlexer.Lexer, out *CommonResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5341,7 +9183,7 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(out *jwriter.Writer, in CommonResponse) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient73(out *jwriter.Writer, in CommonResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5391,27 +9233,130 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v CommonResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommonResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient37(w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommonResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommonResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient37(l, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient73(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient74(in *jlexer.Lexer, out *BookLevel) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "p":
+			out.Price = float64(in.Float64())
+		case "x":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Sizes = make(map[string]float64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v111 float64
+					v111 = float64(in.Float64())
+					(out.Sizes)[key] = v111
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient74(out *jwriter.Writer, in BookLevel) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"p\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Price))
+	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		if in.Sizes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v112First := true
+			for v112Name, v112Value := range in.Sizes {
+				if v112First {
+					v112First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v112Name))
+				out.RawByte(':')
+				out.Float64(float64(v112Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BookLevel) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BookLevel) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient74(w, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(in *jlexer.Lexer, out *Bars) {
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BookLevel) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BookLevel) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient74(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient75(in *jlexer.Lexer, out *Bars) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -5428,9 +9373,9 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(in *jlexer.Lexer, out *
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v63 Bar
-			(v63).UnmarshalEasyJSON(in)
-			*out = append(*out, v63)
+			var v113 Bar
+			(v113).UnmarshalEasyJSON(in)
+			*out = append(*out, v113)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -5439,16 +9384,16 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(out *jwriter.Writer, in Bars) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient75(out *jwriter.Writer, in Bars) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v64, v65 := range in {
-			if v64 > 0 {
+		for v114, v115 := range in {
+			if v114 > 0 {
 				out.RawByte(',')
 			}
-			(v65).MarshalEasyJSON(out)
+			(v115).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
@@ -5457,27 +9402,27 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v Bars) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Bars) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient38(w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Bars) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Bars) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient38(l, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient75(l, v)
 }
-func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(in *jlexer.Lexer, out *Bar) {
+func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient76(in *jlexer.Lexer, out *Bar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5526,7 +9471,7 @@ func easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(out *jwriter.Writer, in Bar) {
+func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient76(out *jwriter.Writer, in Bar) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5586,23 +9531,23 @@ func easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v Bar) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(&w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Bar) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient39(w, v)
+	easyjsonBc289ab0EncodeGithubComGtmkPolygonGclient76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Bar) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(&r, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Bar) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient39(l, v)
+	easyjsonBc289ab0DecodeGithubComGtmkPolygonGclient76(l, v)
 }
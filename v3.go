@@ -0,0 +1,132 @@
+package polygonio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+func (c *Client) v3TicksWithOpts(endpoint string, opts *V3TickOptions) (string, error) {
+	if opts == nil {
+		return endpoint, nil
+	}
+	v, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	optParams := v.Encode()
+	if optParams != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, optParams)
+	}
+	return endpoint, nil
+}
+
+// v3Page fetches a page of a v3 endpoint into v and returns the cursor of the
+// next page, empty on the last one.
+func (c *Client) v3Page(ctx context.Context, endpoint string, v interface{}) (string, error) {
+	next, err := c.GetJSONPage(ctx, endpoint, v)
+	if err != nil || next == "" {
+		return "", err
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	return u.Query().Get("cursor"), nil
+}
+
+// V3TradeIterator iterates over the trades of the v3 trades endpoint one page
+// at a time, following the cursor of each page.
+type V3TradeIterator struct {
+	ctx   context.Context
+	pager *Pager
+	buf   V3Trades
+	cur   V3Trade
+	err   error
+}
+
+// Next advances to the next trade, fetching pages as needed. It returns false
+// when all pages have been read or an error occurred, see Err.
+func (it *V3TradeIterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.err != nil {
+			return false
+		}
+		var out V3TradesResponse
+		if !it.pager.Next(it.ctx, &out) {
+			it.err = it.pager.Err()
+			return false
+		}
+		it.buf = out.Results
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Value returns the current trade.
+func (it *V3TradeIterator) Value() V3Trade {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *V3TradeIterator) Err() error {
+	return it.err
+}
+
+// v3TradesIter walks the pages of /v3/trades/{ticker}.
+func (c *Client) v3TradesIter(ctx context.Context, ticker string, opts *V3TickOptions) *V3TradeIterator {
+	endpoint, err := c.v3TicksWithOpts(fmt.Sprintf("/v3/trades/%s", url.PathEscape(ticker)), opts)
+	if err != nil {
+		return &V3TradeIterator{ctx: ctx, err: err}
+	}
+	return &V3TradeIterator{ctx: ctx, pager: c.NewPager(endpoint)}
+}
+
+// V3QuoteIterator iterates over the quotes of the v3 quotes endpoint, see
+// V3TradeIterator.
+type V3QuoteIterator struct {
+	ctx   context.Context
+	pager *Pager
+	buf   V3Quotes
+	cur   V3Quote
+	err   error
+}
+
+// Next advances to the next quote, fetching pages as needed. It returns false
+// when all pages have been read or an error occurred, see Err.
+func (it *V3QuoteIterator) Next() bool {
+	for len(it.buf) == 0 {
+		if it.err != nil {
+			return false
+		}
+		var out V3QuotesResponse
+		if !it.pager.Next(it.ctx, &out) {
+			it.err = it.pager.Err()
+			return false
+		}
+		it.buf = out.Results
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Value returns the current quote.
+func (it *V3QuoteIterator) Value() V3Quote {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *V3QuoteIterator) Err() error {
+	return it.err
+}
+
+// v3QuotesIter walks the pages of /v3/quotes/{ticker}.
+func (c *Client) v3QuotesIter(ctx context.Context, ticker string, opts *V3TickOptions) *V3QuoteIterator {
+	endpoint, err := c.v3TicksWithOpts(fmt.Sprintf("/v3/quotes/%s", url.PathEscape(ticker)), opts)
+	if err != nil {
+		return &V3QuoteIterator{ctx: ctx, err: err}
+	}
+	return &V3QuoteIterator{ctx: ctx, pager: c.NewPager(endpoint)}
+}
@@ -0,0 +1,41 @@
+package polygonio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStockTradesV3(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Del("apiKey")
+		queries = append(queries, r.URL.Path+"?"+q.Encode())
+		if q.Get("cursor") == "" {
+			fmt.Fprintf(w, `{"results":[{"price":131.5,"size":100,"sip_timestamp":1609770600000000000,"id":"7"}],"next_url":"http://%s/v3/trades/AAPL?cursor=c2&apiKey=KEY"}`, r.Host)
+			return
+		}
+		fmt.Fprint(w, `{"results":[{"price":131.6,"size":50,"sip_timestamp":1609770600000000001}]}`)
+	}))
+	defer srv.Close()
+	client := NewClient("KEY", WithBaseURL(srv.URL))
+
+	opts := &V3TickOptions{TimestampGTE: "1609770600000000000", TimestampLT: "2021-01-05", Order: Asc, Limit: 1}
+	trades, cursor, err := client.StockTradesV3("AAPL", opts)
+	if err != nil || len(trades) != 1 || trades[0].ID != "7" || trades[0].Size != 100 || cursor != "c2" {
+		t.Fatalf("unexpected page %+v, %q, %v", trades, cursor, err)
+	}
+	trades, cursor, err = client.StockTradesV3("AAPL", &V3TickOptions{Cursor: cursor})
+	if err != nil || len(trades) != 1 || trades[0].Price != 131.6 || cursor != "" {
+		t.Fatalf("unexpected last page %+v, %q, %v", trades, cursor, err)
+	}
+	want := []string{
+		"/v3/trades/AAPL?limit=1&order=asc&timestamp.gte=1609770600000000000&timestamp.lt=2021-01-05",
+		"/v3/trades/AAPL?cursor=c2",
+	}
+	if fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Fatalf("expected requests %v, got %v", want, queries)
+	}
+}